@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	battle "github.com/avgra3/pokedexcli/internal/battle"
+	pokeapi "github.com/avgra3/pokedexcli/internal/pokeapi"
+	pokecache "github.com/avgra3/pokedexcli/internal/pokecache"
+	typechart "github.com/avgra3/pokedexcli/internal/typechart"
+)
+
+// battleLevel is the level both sides fight at
+const battleLevel = 50
+
+func commandBattle(ctx context.Context, configuration *config, cache *pokecache.Cache, args []string) error {
+	generation, args, err := generationOption(args)
+	if err != nil {
+		return err
+	}
+	mine, wild := argument(args, 0), argument(args, 1)
+	if mine == "" || wild == "" {
+		return errors.New("usage: battle [--gen N] <MY_POKEMON> <WILD_POKEMON>")
+	}
+	myPokemon, ok := configuration.UserPokedex[mine]
+	if !ok {
+		return fmt.Errorf("you have not caught %v", mine)
+	}
+	wildPokemon, err := configuration.pokeapiClient.Pokemon(ctx, wild)
+	if err != nil {
+		return friendlyError(ctx, configuration, "pokemon", "Pokemon", wild, err)
+	}
+	chart, err := configuration.loadTypeChart(ctx, generation)
+	if err != nil {
+		return typeChartError(ctx, configuration, err)
+	}
+	myCombatant, err := newCombatant(ctx, configuration, myPokemon, generation)
+	if err != nil {
+		return err
+	}
+	wildCombatant, err := newCombatant(ctx, configuration, wildPokemon, generation)
+	if err != nil {
+		return err
+	}
+	// Tell the wild Pokemon apart from ours, in case they are the same species
+	wildCombatant.Name = "wild " + wildCombatant.Name
+
+	fight := battle.New(chart, myCombatant, wildCombatant, configuration.random())
+	fmt.Printf("%v (%v HP) vs %v (%v HP), both at level %v%v\n", myCombatant.Name, myCombatant.HP, wildCombatant.Name, wildCombatant.HP, battleLevel, generationLabel(generation))
+	maxHP := map[string]int{myCombatant.Name: myCombatant.Stats.HP, wildCombatant.Name: wildCombatant.Stats.HP}
+	for !fight.Over() {
+		if ctx.Err() != nil {
+			return friendlyError(ctx, configuration, "", "", "", ctx.Err())
+		}
+		events := fight.Turn()
+		fmt.Printf("Turn %v:\n", fight.TurnNumber())
+		for _, event := range events {
+			fmt.Printf("\t%v\n", describeBattleEvent(event, maxHP))
+			for _, fainted := range event.Fainted {
+				fmt.Printf("\t%v fainted!\n", fainted)
+			}
+		}
+	}
+	winner := fight.Winner()
+	if winner == nil {
+		fmt.Printf("Neither side could win after %v turns, it's a draw\n", fight.TurnNumber())
+		return nil
+	}
+	fmt.Printf("%v won!\n", winner.Name)
+	return nil
+}
+
+// newCombatant readies a Pokemon for battle with the moves it would know at battleLevel and
+// the types it had in generation (0 for the current one).
+// Only the most recently learned moves are fetched, a batch at a time, until it has enough damaging ones.
+func newCombatant(ctx context.Context, configuration *config, pokemon pokeapi.Pokemon, generation int) (battle.Combatant, error) {
+	candidates := battle.LearnableMoves(pokemon, battleLevel)
+	moves := []pokeapi.Move{}
+	damaging := 0
+	for start := 0; start < len(candidates) && damaging < battle.MaxMoves; start += battle.MaxMoves {
+		batch := candidates[start:min(start+battle.MaxMoves, len(candidates))]
+		for _, result := range pokeapi.ResolveAll(ctx, configuration.pokeapiClient, batch, len(batch)) {
+			if result.Err != nil {
+				return battle.Combatant{}, friendlyError(ctx, configuration, "move", "move", result.Name, result.Err)
+			}
+			moves = append(moves, result.Value)
+			if battle.Damaging(result.Value) {
+				damaging++
+			}
+		}
+	}
+	combatant := battle.NewCombatant(pokemon, moves, battleLevel)
+	combatant.Types = typechart.PokemonTypes(pokemon, generation)
+	return combatant, nil
+}
+
+func describeBattleEvent(event battle.Event, maxHP map[string]int) string {
+	used := fmt.Sprintf("%v used %v", event.Attacker, event.Move)
+	if event.Missed {
+		return used + ", but it missed"
+	}
+	if event.Multiplier == 0 {
+		return fmt.Sprintf("%v, but it doesn't affect %v", used, event.Defender)
+	}
+	description := used + ":"
+	if event.Critical {
+		description += " a critical hit!"
+	}
+	if event.Multiplier != 1 {
+		description += " " + effectiveness(event.Multiplier) + "!"
+	}
+	description += fmt.Sprintf(" %v lost %v HP (%v/%v left)", event.Defender, event.Damage, event.DefenderHP, maxHP[event.Defender])
+	if event.Recoil > 0 {
+		description += fmt.Sprintf(", %v took %v recoil damage (%v/%v left)", event.Attacker, event.Recoil, event.AttackerHP, maxHP[event.Attacker])
+	}
+	return description
+}
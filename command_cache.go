@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	pokecache "github.com/avgra3/pokedexcli/internal/pokecache"
+)
+
+func commandCache(ctx context.Context, configuration *config, cache *pokecache.Cache, args []string) error {
+	// Keys are case sensitive, so the REPL keeps the case of args; subcommands are not
+	switch strings.ToLower(argument(args, 0)) {
+	case "stats":
+		stats := cache.Stats()
+		fmt.Println("Cache:")
+		fmt.Printf("\t- entries: %v\n", stats.Entries)
+		fmt.Printf("\t- size: %v\n", formatBytes(stats.Bytes))
+		fmt.Printf("\t- hits: %v\n", stats.Hits)
+		fmt.Printf("\t- misses: %v\n", stats.Misses)
+		fmt.Printf("\t- evictions: %v\n", stats.Evictions)
+		clientStats := configuration.pokeapiClient.Stats()
+		fmt.Println("PokeAPI:")
+		fmt.Printf("\t- requests: %v\n", clientStats.Requests)
+		fmt.Printf("\t- retries: %v\n", clientStats.Retries)
+		fmt.Printf("\t- throttled: %v\n", clientStats.Throttled)
+		fmt.Printf("\t- rate limit waits: %v\n", clientStats.RateLimitWaits)
+		fmt.Printf("\t- coalesced: %v\n", clientStats.Coalesced)
+		fmt.Printf("\t- revalidated (304): %v\n", clientStats.Revalidated)
+		return nil
+	case "list":
+		entries := cache.Entries()
+		if len(entries) == 0 {
+			fmt.Println("The cache is empty")
+			return nil
+		}
+		fmt.Println("Cached responses (most recently used first):")
+		for _, entry := range entries {
+			age := time.Since(entry.CreatedAt).Round(time.Second)
+			fmt.Printf("\t- %v (%v, cached %v ago)\n", entry.Key, formatBytes(entry.Size), age)
+		}
+		return nil
+	case "clear":
+		err := cache.Clear()
+		if err != nil {
+			return err
+		}
+		fmt.Println("Cache cleared")
+		return nil
+	case "evict":
+		key := argument(args, 1)
+		if key == "" {
+			return errors.New("usage: cache evict <KEY>")
+		}
+		found, err := cache.Delete(key)
+		if err != nil {
+			return err
+		}
+		if found {
+			fmt.Printf("Evicted %v\n", key)
+		} else {
+			fmt.Printf("%v was not cached in memory\n", key)
+		}
+		return nil
+	}
+	return errors.New("usage: cache <stats|list|clear|evict <KEY>>")
+}
+
+// formatBytes renders a size for humans, e.g. 2048 as "2.0 KiB"
+func formatBytes(size int) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	value := float64(size) / unit
+	for _, suffix := range []string{"KiB", "MiB"} {
+		if value < unit {
+			return fmt.Sprintf("%.1f %v", value, suffix)
+		}
+		value /= unit
+	}
+	return fmt.Sprintf("%.1f GiB", value)
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	pokeapi "github.com/avgra3/pokedexcli/internal/pokeapi"
+	pokecache "github.com/avgra3/pokedexcli/internal/pokecache"
+)
+
+func commandEvolution(ctx context.Context, configuration *config, cache *pokecache.Cache, args []string) error {
+	input := argument(args, 0)
+	if input == "" {
+		return errors.New("usage: evolution <POKEMON_NAME>")
+	}
+	pokemon, err := configuration.pokeapiClient.Pokemon(ctx, input)
+	if err != nil {
+		return friendlyError(ctx, configuration, "pokemon", "Pokemon", input, err)
+	}
+	species, err := pokemon.Species.Resolve(ctx, configuration.pokeapiClient)
+	if err != nil {
+		return friendlyError(ctx, configuration, "pokemon-species", "Pokemon species", pokemon.Species.Name, err)
+	}
+	chain, err := species.EvolutionChain.Resolve(ctx, configuration.pokeapiClient)
+	if errors.Is(err, pokeapi.ErrNotFound) || (err == nil && chain.Chain == nil) {
+		return fmt.Errorf("%v has no evolution chain", pokemon.Name)
+	}
+	if err != nil {
+		return friendlyError(ctx, configuration, "evolution-chain", "evolution chain", "", err)
+	}
+	fmt.Print(renderEvolutionTree(*chain.Chain))
+	return nil
+}
+
+// renderEvolutionTree draws a chain as an ASCII tree, one species per line,
+// each evolution annotated with what triggers it:
+//
+//	eevee
+//	|-- vaporeon (use water-stone)
+//	`-- espeon (high friendship, daytime)
+func renderEvolutionTree(link pokeapi.ChainLink) string {
+	var tree strings.Builder
+	tree.WriteString(link.Species.Name + "\n")
+	renderEvolutions(&tree, link.EvolvesTo, "")
+	return tree.String()
+}
+
+func renderEvolutions(tree *strings.Builder, links []pokeapi.ChainLink, indent string) {
+	for i, link := range links {
+		branch, childIndent := "|-- ", "|   "
+		if i == len(links)-1 {
+			branch, childIndent = "`-- ", "    "
+		}
+		tree.WriteString(indent + branch + link.Species.Name)
+		if conditions := describeEvolution(link.EvolutionDetails); conditions != "" {
+			tree.WriteString(" (" + conditions + ")")
+		}
+		tree.WriteString("\n")
+		renderEvolutions(tree, link.EvolvesTo, indent+childIndent)
+	}
+}
+
+// describeEvolution summarises how to evolve into a species. A species may list
+// several ways (usually from different games), any of which works.
+func describeEvolution(details []pokeapi.EvolutionDetail) string {
+	ways := []string{}
+	for _, detail := range details {
+		if way := describeEvolutionDetail(detail); way != "" && !slices.Contains(ways, way) {
+			ways = append(ways, way)
+		}
+	}
+	return strings.Join(ways, " or ")
+}
+
+func describeEvolutionDetail(detail pokeapi.EvolutionDetail) string {
+	conditions := []string{}
+	switch detail.Trigger.Name {
+	case "level-up":
+		if detail.MinLevel > 0 {
+			conditions = append(conditions, fmt.Sprintf("level %v", detail.MinLevel))
+		}
+	case "use-item":
+		conditions = append(conditions, "use "+detail.Item.Name)
+	case "trade":
+		trade := "trade"
+		if detail.HeldItem.Name != "" {
+			trade += " holding " + detail.HeldItem.Name
+		}
+		if detail.TradeSpecies.Name != "" {
+			trade += " for " + detail.TradeSpecies.Name
+		}
+		conditions = append(conditions, trade)
+	case "":
+	default:
+		conditions = append(conditions, strings.ReplaceAll(detail.Trigger.Name, "-", " "))
+	}
+
+	if detail.HeldItem.Name != "" && detail.Trigger.Name != "trade" {
+		conditions = append(conditions, "holding "+detail.HeldItem.Name)
+	}
+	if detail.KnownMove.Name != "" {
+		conditions = append(conditions, "knows "+detail.KnownMove.Name)
+	}
+	if detail.KnownMoveType.Name != "" {
+		conditions = append(conditions, "knows a "+detail.KnownMoveType.Name+" move")
+	}
+	if detail.MinHappiness > 0 {
+		conditions = append(conditions, "high friendship")
+	}
+	if detail.MinBeauty > 0 {
+		conditions = append(conditions, "high beauty")
+	}
+	if detail.MinAffection > 0 {
+		conditions = append(conditions, "high affection")
+	}
+	switch detail.TimeOfDay {
+	case "":
+	case "day", "night":
+		conditions = append(conditions, detail.TimeOfDay+"time")
+	default:
+		conditions = append(conditions, "at "+detail.TimeOfDay)
+	}
+	if detail.Location.Name != "" {
+		conditions = append(conditions, "at "+detail.Location.Name)
+	}
+	if detail.Gender != nil {
+		// The PokeAPI numbers genders 1 (female) and 2 (male)
+		if *detail.Gender == 1 {
+			conditions = append(conditions, "female")
+		} else {
+			conditions = append(conditions, "male")
+		}
+	}
+	if detail.RelativePhysicalStats != nil {
+		switch {
+		case *detail.RelativePhysicalStats > 0:
+			conditions = append(conditions, "attack > defense")
+		case *detail.RelativePhysicalStats < 0:
+			conditions = append(conditions, "attack < defense")
+		default:
+			conditions = append(conditions, "attack = defense")
+		}
+	}
+	if detail.PartySpecies.Name != "" {
+		conditions = append(conditions, "with "+detail.PartySpecies.Name+" in the party")
+	}
+	if detail.PartyType.Name != "" {
+		conditions = append(conditions, "with a "+detail.PartyType.Name+" type in the party")
+	}
+	if detail.NeedsOverworldRain {
+		conditions = append(conditions, "while raining")
+	}
+	if detail.TurnUpsideDown {
+		conditions = append(conditions, "console upside down")
+	}
+	// A plain level up with none of the above
+	if len(conditions) == 0 && detail.Trigger.Name == "level-up" {
+		conditions = append(conditions, "level up")
+	}
+	return strings.Join(conditions, ", ")
+}
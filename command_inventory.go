@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pokeapi "github.com/avgra3/pokedexcli/internal/pokeapi"
+	pokecache "github.com/avgra3/pokedexcli/internal/pokecache"
+)
+
+// defaultBall is thrown by catch unless another ball is asked for
+const defaultBall = "poke-ball"
+
+// itemConcurrency is how many items are fetched at once
+const itemConcurrency = 8
+
+func commandInventory(ctx context.Context, configuration *config, cache *pokecache.Cache, args []string) error {
+	entries := configuration.bag().Entries()
+	if len(entries) == 0 {
+		fmt.Println("Your bag is empty")
+		return nil
+	}
+	urls := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		urls = append(urls, configuration.pokeapiClient.ResourceURL("item", entry.Name))
+	}
+	items := pokeapi.GetMany[pokeapi.Item](ctx, configuration.pokeapiClient, urls, itemConcurrency)
+	if ctx.Err() != nil {
+		return friendlyError(ctx, configuration, "", "", "", ctx.Err())
+	}
+
+	fmt.Println("Your bag:")
+	for i, entry := range entries {
+		// The count is what matters, so an item the PokeAPI can't describe is still listed
+		if items[i].Err != nil {
+			fmt.Printf("\t- %v x%v\n", ballLabel(entry.Name), entry.Count)
+			continue
+		}
+		item := items[i].Value
+		fmt.Printf("\t- %v x%v", item.LocalizedName(configuration.language), entry.Count)
+		if description := item.FlavorText(configuration.language); description != "" {
+			fmt.Printf(": %v", description)
+		}
+		fmt.Println()
+	}
+	return nil
+}
+
+// ballLabel turns a ball's API name into something readable without asking the PokeAPI,
+// e.g. "great-ball" into "Great Ball"
+func ballLabel(name string) string {
+	words := strings.Split(name, "-")
+	for i, word := range words {
+		if word != "" {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pokeapi "github.com/avgra3/pokedexcli/internal/pokeapi"
+	pokecache "github.com/avgra3/pokedexcli/internal/pokecache"
+	typechart "github.com/avgra3/pokedexcli/internal/typechart"
+)
+
+// matchupRows are the multipliers matchup lists, from weakest to most resistant
+var matchupRows = []float64{4, 2, 1, 0.5, 0.25, 0}
+
+func commandMatchup(ctx context.Context, configuration *config, cache *pokecache.Cache, args []string) error {
+	generation, args, err := generationOption(args)
+	if err != nil {
+		return err
+	}
+	attack, input := "", argument(args, 0)
+	if len(args) > 1 {
+		attack, input = args[0], args[1]
+	}
+	if input == "" || len(args) > 2 {
+		return errors.New("usage: matchup [--gen N] [ATTACK_TYPE] <POKEMON_NAME>")
+	}
+	chart, err := configuration.loadTypeChart(ctx, generation)
+	if err != nil {
+		return typeChartError(ctx, configuration, err)
+	}
+	if attack != "" && !chart.Has(attack) {
+		return unknownTypeError(chart, attack)
+	}
+	pokemon, err := configuration.pokeapiClient.Pokemon(ctx, input)
+	if err != nil {
+		return friendlyError(ctx, configuration, "pokemon", "Pokemon", input, err)
+	}
+	defenders := typechart.PokemonTypes(pokemon, generation)
+
+	if attack != "" {
+		multiplier, err := chart.Multiplier(attack, defenders...)
+		if err != nil {
+			return typeError(chart, err)
+		}
+		fmt.Printf("%v against %v (%v)%v: %v, %v\n", attack, pokemon.Name, strings.Join(defenders, "/"), generationLabel(generation), formatMultiplier(multiplier), effectiveness(multiplier))
+		return nil
+	}
+
+	matchups, err := chart.Defense(defenders...)
+	if err != nil {
+		return typeError(chart, err)
+	}
+	fmt.Printf("%v (%v)%v\n", pokemon.Name, strings.Join(defenders, "/"), generationLabel(generation))
+	for _, row := range matchupRows {
+		attacks := []string{}
+		for _, matchup := range matchups {
+			if matchup.Multiplier == row {
+				attacks = append(attacks, matchup.Type)
+			}
+		}
+		if len(attacks) > 0 {
+			fmt.Printf("%v: %v\n", formatMultiplier(row), strings.Join(attacks, ", "))
+		}
+	}
+	return nil
+}
+
+// generationOption takes a "--gen N" option out of args; without one the generation is 0, the current one
+func generationOption(args []string) (int, []string, error) {
+	value, args, err := option(args, "gen")
+	if err != nil || value == "" {
+		return 0, args, err
+	}
+	generation, err := typechart.ParseGeneration(value)
+	if err != nil {
+		return 0, args, fmt.Errorf("--gen wants a generation like 3 or iii, not '%v'", value)
+	}
+	return generation, args, nil
+}
+
+func generationLabel(generation int) string {
+	if generation == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" in generation %v", generation)
+}
+
+// loadTypeChart returns the type chart for generation (0 for the current one),
+// fetching the types the first time a chart is needed
+func (c *config) loadTypeChart(ctx context.Context, generation int) (*typechart.Chart, error) {
+	if c.typeChart == nil {
+		chart, err := typechart.Load(ctx, c.pokeapiClient)
+		if err != nil {
+			return nil, err
+		}
+		c.typeChart = chart
+	}
+	return c.typeChart.AtGeneration(generation), nil
+}
+
+// typeChartError explains why the type chart could not be loaded. A 404 here is the PokeAPI
+// missing a type it listed, not a name the user got wrong, so there is nothing to suggest.
+func typeChartError(ctx context.Context, configuration *config, err error) error {
+	var statusErr *pokeapi.HTTPStatusError
+	if errors.As(err, &statusErr) && errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("could not load the type chart, the PokeAPI has nothing at %v", statusErr.URL)
+	}
+	return friendlyError(ctx, configuration, "type", "type", "", err)
+}
+
+func unknownTypeError(chart *typechart.Chart, typeName string) error {
+	if chart.Generation() > 0 && chart.AtGeneration(0).Has(typeName) {
+		return fmt.Errorf("the %v type did not exist yet in generation %v", typeName, chart.Generation())
+	}
+	message := fmt.Sprintf("no type named '%v'", typeName)
+	if match, ok := closestMatch(typeName, chart.Types()); ok {
+		message = fmt.Sprintf("%v — did you mean %v?", message, match)
+	}
+	return errors.New(message)
+}
+
+// typeError turns a typechart.UnknownTypeError, e.g. for a Pokemon whose type came after the
+// chart's generation, into the same message as an unknown ATTACK_TYPE
+func typeError(chart *typechart.Chart, err error) error {
+	var unknown *typechart.UnknownTypeError
+	if errors.As(err, &unknown) {
+		return unknownTypeError(chart, unknown.Type)
+	}
+	return err
+}
+
+func formatMultiplier(multiplier float64) string {
+	return strconv.FormatFloat(multiplier, 'f', -1, 64) + "x"
+}
+
+func effectiveness(multiplier float64) string {
+	switch {
+	case multiplier == 0:
+		return "no effect"
+	case multiplier < 1:
+		return "not very effective"
+	case multiplier > 1:
+		return "super effective"
+	default:
+		return "normal damage"
+	}
+}
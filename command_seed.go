@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	pokecache "github.com/avgra3/pokedexcli/internal/pokecache"
+	random "github.com/avgra3/pokedexcli/internal/random"
+)
+
+func commandSeed(ctx context.Context, configuration *config, cache *pokecache.Cache, args []string) error {
+	input := argument(args, 0)
+	if input == "" {
+		fmt.Printf("Seed: %v\n", configuration.random().Seed())
+		return nil
+	}
+	seed, err := parseSeed(input)
+	if err != nil {
+		return err
+	}
+	configuration.rng = random.New(seed)
+	fmt.Printf("Catches and battles now roll from seed %v\n", seed)
+	return nil
+}
+
+// parseSeed reads a seed given to --seed or the seed command
+func parseSeed(value string) (uint64, error) {
+	seed, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("a seed is a whole number from 0 up, not '%v'", value)
+	}
+	return seed, nil
+}
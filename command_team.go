@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	pokecache "github.com/avgra3/pokedexcli/internal/pokecache"
+	typechart "github.com/avgra3/pokedexcli/internal/typechart"
+)
+
+// maxTeamSize is how many Pokemon a team can have in the games
+const maxTeamSize = 6
+
+func commandTeam(ctx context.Context, configuration *config, cache *pokecache.Cache, args []string) error {
+	if argument(args, 0) != "analyze" {
+		return errors.New("usage: team analyze [--gen N] <POKEMON_NAME>...")
+	}
+	generation, names, err := generationOption(args[1:])
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 || len(names) > maxTeamSize {
+		return fmt.Errorf("a team has between 1 and %v Pokemon from your Pokedex", maxTeamSize)
+	}
+	team := []typechart.Member{}
+	for _, name := range names {
+		pokemon, ok := configuration.UserPokedex[name]
+		if !ok {
+			return fmt.Errorf("you have not caught %v", name)
+		}
+		team = append(team, typechart.Member{Name: pokemon.Name, Types: typechart.PokemonTypes(pokemon, generation)})
+	}
+	chart, err := configuration.loadTypeChart(ctx, generation)
+	if err != nil {
+		return typeChartError(ctx, configuration, err)
+	}
+	report, err := chart.AnalyzeTeam(team)
+	if err != nil {
+		return typeError(chart, err)
+	}
+
+	members := []string{}
+	for _, member := range team {
+		members = append(members, fmt.Sprintf("%v (%v)", member.Name, strings.Join(member.Types, "/")))
+	}
+	fmt.Printf("Team%v: %v\n", generationLabel(generation), strings.Join(members, ", "))
+	fmt.Println("Shared weaknesses:")
+	if len(report.SharedWeaknesses) == 0 {
+		fmt.Println("\t- none")
+	}
+	for _, weakness := range report.SharedWeaknesses {
+		fmt.Printf("\t- %v (%v of %v)\n", weakness.Type, weakness.Count, len(team))
+	}
+	printTypeList("Unresisted attacking types:", report.Unresisted)
+	printTypeList("STAB coverage gaps:", report.CoverageGaps)
+	if report.Suggestion == "" {
+		fmt.Println("Suggested seventh type: none, no single type would help")
+		return nil
+	}
+	fixes := report.SuggestionFixes
+	fmt.Printf("Suggested seventh type: %v (resists %v shared weaknesses and %v unresisted types, covers %v gaps)\n",
+		report.Suggestion, fixes.SharedWeaknesses, fixes.Unresisted, fixes.CoverageGaps)
+	return nil
+}
+
+func printTypeList(title string, types []string) {
+	fmt.Println(title)
+	if len(types) == 0 {
+		fmt.Println("\t- none")
+	}
+	for _, typeName := range types {
+		fmt.Printf("\t- %v\n", typeName)
+	}
+}
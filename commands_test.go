@@ -1,6 +1,17 @@
 package main
 
-import "testing"
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	httprecord "github.com/avgra3/pokedexcli/internal/httprecord"
+	pokeapi "github.com/avgra3/pokedexcli/internal/pokeapi"
+	pokeapitest "github.com/avgra3/pokedexcli/internal/pokeapitest"
+	pokecache "github.com/avgra3/pokedexcli/internal/pokecache"
+)
 
 func TestCommands(t *testing.T) {
 	// Setup of test case structs
@@ -52,3 +63,180 @@ func TestCommands(t *testing.T) {
 	} */
 
 }
+
+func TestClosestMatch(t *testing.T) {
+	candidates := []string{"pikachu", "raichu", "bulbasaur", "charmander"}
+	cases := []struct {
+		input    string
+		expected string
+		found    bool
+	}{
+		{input: "pikachuu", expected: "pikachu", found: true},
+		{input: "charmandr", expected: "charmander", found: true},
+		{input: "bulbasuar", expected: "bulbasaur", found: true},
+		{input: "mewtwo", expected: "", found: false},
+	}
+
+	for _, c := range cases {
+		actual, found := closestMatch(c.input, candidates)
+		if found != c.found || actual != c.expected {
+			t.Errorf("closestMatch(%v): Expected: %v (%v); Got: %v (%v)", c.input, c.expected, c.found, actual, found)
+		}
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	cases := []struct {
+		input    int
+		expected string
+	}{
+		{input: 512, expected: "512 B"},
+		{input: 2048, expected: "2.0 KiB"},
+		{input: 5 << 20, expected: "5.0 MiB"},
+	}
+	for _, c := range cases {
+		actual := formatBytes(c.input)
+		if actual != c.expected {
+			t.Errorf("Expected: %v; Got: %v", c.expected, actual)
+		}
+	}
+}
+
+// fixtureDir is shared with the pokeapi package's tests, so there is only one set of fixtures to refresh
+const fixtureDir = "internal/pokeapi/testdata/fixtures"
+
+// newTestConfig returns a configuration whose client is backed by the fixtures in fixtureDir.
+// Run the tests with POKEDEXCLI_RECORD=1 to refresh them from the real PokeAPI.
+func newTestConfig() *config {
+	recorder := httprecord.New(fixtureDir, httprecord.ModeFromEnv())
+	return &config{
+		UserPokedex: make(map[string]pokeapi.Pokemon),
+		pokeapiClient: pokeapi.NewClient(
+			pokecache.NewCache(),
+			pokeapi.WithHTTPClient(recorder.Client()),
+			pokeapi.WithRetryPolicy(pokeapi.RetryPolicy{MaxAttempts: 1}),
+		),
+		clock: fixedClock(testNoon),
+	}
+}
+
+// captureOutput runs a command and returns what it printed
+func captureOutput(t *testing.T, command func() error) string {
+	t.Helper()
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stdout := os.Stdout
+	os.Stdout = writer
+	output := make(chan string)
+	go func() {
+		data, _ := io.ReadAll(reader)
+		output <- string(data)
+	}()
+	err = command()
+	os.Stdout = stdout
+	writer.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return <-output
+}
+
+func TestCommandMap(t *testing.T) {
+	configuration := newTestConfig()
+	output := captureOutput(t, func() error {
+		return commandMap(context.Background(), configuration, nil, nil)
+	})
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 20 || lines[0] != "canalave-city-area" || lines[19] != "mt-coronet-1f-from-exterior" {
+		t.Errorf("unexpected output: %v", output)
+	}
+	if configuration.locationAreaPages().Number() != 0 {
+		t.Errorf("expected map to remember it is on the first page")
+	}
+}
+
+func TestCommandExplore(t *testing.T) {
+	configuration := newTestConfig()
+	output := captureOutput(t, func() error {
+		return commandExplore(context.Background(), configuration, nil, []string{"canalave-city-area"})
+	})
+	if !strings.HasPrefix(output, "Exploring canalave-city-area...\n") {
+		t.Errorf("unexpected output: %v", output)
+	}
+	for _, name := range []string{"tentacool", "magikarp", "lumineon"} {
+		if !strings.Contains(output, "- "+name+"\n") {
+			t.Errorf("expected %v in output: %v", name, output)
+		}
+	}
+}
+
+func TestCommandCatchAndInspect(t *testing.T) {
+	configuration := newTestConfig()
+	output := captureOutput(t, func() error {
+		return commandCatch(context.Background(), configuration, nil, []string{"pikachu"})
+	})
+	if !strings.HasPrefix(output, "Throwing a Poke Ball at pikachu...\n") {
+		t.Errorf("unexpected output: %v", output)
+	}
+	_, caught := configuration.UserPokedex["pikachu"]
+	if caught != strings.Contains(output, "pikachu was caught!") {
+		t.Errorf("the Pokedex does not match the output: %v", output)
+	}
+
+	// Make sure it is caught, whatever the throw did
+	pikachu, err := configuration.pokeapiClient.Pokemon(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	configuration.UserPokedex["pikachu"] = pikachu
+	output = captureOutput(t, func() error {
+		return commandInspect(context.Background(), configuration, nil, []string{"pikachu"})
+	})
+	for _, expected := range []string{
+		"Name: pikachu\n", "Height: 4\n", "\t- speed:  90\n", "\t- electric\n",
+		"Genus: Mouse Pokémon\n", "Legendary: no\n", "Habitat: forest\n", "Capture rate: 190\n", "\t- fairy\n",
+		"Pokedex entry: It occasionally uses an electric shock to recharge a fellow Pikachu that is in a weakened state.\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected %q in output: %v", expected, output)
+		}
+	}
+
+	configuration.language = "fr"
+	output = captureOutput(t, func() error {
+		return commandInspect(context.Background(), configuration, nil, []string{"pikachu"})
+	})
+	for _, expected := range []string{"Genus: Pokémon Souris\n", "Pokedex entry: Il lui arrive de remettre en marche"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected %q in output: %v", expected, output)
+		}
+	}
+}
+
+func TestRenderEvolutionTree(t *testing.T) {
+	female := 1
+	tradeHolding := pokeapi.EvolutionDetail{
+		Trigger:  pokeapitest.Ref[pokeapi.EvolutionTrigger]("evolution-trigger", 2, "trade"),
+		HeldItem: pokeapitest.Ref[pokeapi.Item]("item", 210, "metal-coat"),
+	}
+	femaleAtLevel := pokeapi.EvolutionDetail{
+		Trigger:  pokeapitest.Ref[pokeapi.EvolutionTrigger]("evolution-trigger", 1, "level-up"),
+		MinLevel: 20,
+		Gender:   &female,
+	}
+	chain := pokeapitest.Evolution(95, "onix", nil,
+		pokeapitest.Evolution(208, "steelix", []pokeapi.EvolutionDetail{tradeHolding},
+			pokeapitest.Evolution(9999, "imaginary-onix", []pokeapi.EvolutionDetail{femaleAtLevel}),
+		),
+		pokeapitest.Evolution(9998, "other-onix", []pokeapi.EvolutionDetail{{}}),
+	)
+	expected := "onix\n" +
+		"|-- steelix (trade holding metal-coat)\n" +
+		"|   `-- imaginary-onix (level 20, female)\n" +
+		"`-- other-onix\n"
+	if actual := renderEvolutionTree(chain); actual != expected {
+		t.Errorf("Expected:\n%v\nGot:\n%v", expected, actual)
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pokeapi "github.com/avgra3/pokedexcli/internal/pokeapi"
+)
+
+// friendlyError turns errors from the API layer into messages meant for the REPL user.
+// resource is the PokeAPI endpoint (e.g. "pokemon") and label is how we name it to the user.
+func friendlyError(ctx context.Context, configuration *config, resource, label, name string, err error) error {
+	if errors.Is(err, context.Canceled) {
+		return errors.New("cancelled")
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return errors.New("the PokeAPI took too long to answer, try again or raise --timeout")
+	}
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		message := fmt.Sprintf("no %v named '%v'", label, name)
+		// Best effort: a failed lookup of the names just means no suggestion
+		names, namesErr := configuration.pokeapiClient.Names(ctx, resource)
+		if namesErr == nil {
+			if match, ok := closestMatch(name, names); ok {
+				message = fmt.Sprintf("%v — did you mean %v?", message, match)
+			}
+		}
+		return errors.New(message)
+	}
+	var statusErr *pokeapi.HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return fmt.Errorf("the PokeAPI could not answer right now (status %d), please try again", statusErr.StatusCode)
+	}
+	var decodeErr *pokeapi.DecodeError
+	if errors.As(err, &decodeErr) {
+		return fmt.Errorf("the PokeAPI sent a response we could not understand for %v", decodeErr.URL)
+	}
+	return err
+}
+
+// closestMatch finds the candidate with the smallest edit distance to name,
+// as long as it is close enough to plausibly be a typo
+func closestMatch(name string, candidates []string) (string, bool) {
+	maxDistance := len(name)/3 + 1
+	best := ""
+	bestDistance := maxDistance + 1
+	for _, candidate := range candidates {
+		distance := levenshtein(name, candidate)
+		if distance < bestDistance {
+			best = candidate
+			bestDistance = distance
+		}
+	}
+	return best, best != ""
+}
+
+func levenshtein(a, b string) int {
+	source := []rune(a)
+	target := []rune(b)
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(target)]
+}
@@ -0,0 +1,29 @@
+package atomicfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFile(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "entry.json")
+	for _, contents := range []string{"first", "second"} {
+		if err := WriteFile(file, []byte(contents)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		data, err := os.ReadFile(file)
+		if err != nil || string(data) != contents {
+			t.Errorf("Expected: %v; Got: %v (%v)", contents, string(data), err)
+		}
+	}
+	// No temporary files are left behind
+	if files, _ := os.ReadDir(dir); len(files) != 1 {
+		t.Errorf("expected only the file itself; Got: %v", files)
+	}
+
+	if err := WriteFile(filepath.Join(dir, "missing", "entry.json"), []byte("x")); err == nil {
+		t.Errorf("expected an error for a missing directory")
+	}
+}
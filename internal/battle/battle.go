@@ -0,0 +1,229 @@
+// Package battle simulates a one-on-one battle between two Pokemon, turn by turn,
+// with the damage formula of the mainline games.
+package battle
+
+import (
+	"slices"
+
+	random "github.com/avgra3/pokedexcli/internal/random"
+	typechart "github.com/avgra3/pokedexcli/internal/typechart"
+)
+
+// MaxTurns ends battles that would otherwise never finish, e.g. when neither side can hurt the other
+const MaxTurns = 100
+
+const (
+	// stabBonus is the boost for a move of one of its user's own types (same-type attack bonus)
+	stabBonus = 1.5
+	// criticalBonus is the boost of a critical hit, as of generation 6
+	criticalBonus = 1.5
+	// criticalChance is one in criticalChance, as of generation 7
+	criticalChance = 24
+	// minRoll and maxRoll bound the random percentage every hit's damage is scaled by
+	minRoll = 85
+	maxRoll = 100
+)
+
+// Battle is a battle in progress between two combatants
+type Battle struct {
+	chart *typechart.Chart
+	rng   random.Source
+	sides [2]*Combatant
+	turn  int
+}
+
+// Event is something that happened during a turn: a move being used, or recoil
+type Event struct {
+	Attacker string
+	Defender string
+	Move     string
+	// Missed is set when the move did not hit
+	Missed bool
+	// Critical is set for critical hits
+	Critical bool
+	// Multiplier is the type effectiveness of the move, 0 when the defender is immune
+	Multiplier float64
+	Damage     int
+	// Recoil is damage the attacker took from its own move
+	Recoil int
+	// AttackerHP and DefenderHP are what they have left afterwards
+	AttackerHP int
+	DefenderHP int
+	// Fainted names whoever fainted because of this event
+	Fainted []string
+}
+
+// New starts a battle between two combatants, rolling dice with rng
+// (nil for one seeded at random). The battle works on copies: PP spent in it doesn't
+// drain the combatants passed in, so they are ready for a rematch.
+func New(chart *typechart.Chart, first, second Combatant, rng random.Source) *Battle {
+	if rng == nil {
+		rng = random.NewRandom()
+	}
+	first.Moves = slices.Clone(first.Moves)
+	second.Moves = slices.Clone(second.Moves)
+	return &Battle{chart: chart, rng: rng, sides: [2]*Combatant{&first, &second}}
+}
+
+// Combatants returns both sides as they are now
+func (b *Battle) Combatants() (Combatant, Combatant) {
+	return *b.sides[0], *b.sides[1]
+}
+
+// TurnNumber is how many turns have been played
+func (b *Battle) TurnNumber() int {
+	return b.turn
+}
+
+// Over reports whether the battle has finished
+func (b *Battle) Over() bool {
+	return b.sides[0].Fainted() || b.sides[1].Fainted() || b.turn >= MaxTurns
+}
+
+// Winner returns the side still standing, or nil for a draw or a battle still going on
+func (b *Battle) Winner() *Combatant {
+	switch {
+	case b.sides[0].Fainted() && !b.sides[1].Fainted():
+		return b.sides[1]
+	case b.sides[1].Fainted() && !b.sides[0].Fainted():
+		return b.sides[0]
+	}
+	return nil
+}
+
+// Turn plays one turn: each side uses its best move, in order of priority then speed
+func (b *Battle) Turn() []Event {
+	if b.Over() {
+		return nil
+	}
+	b.turn++
+	moves := [2]int{b.chooseMove(b.sides[0], b.sides[1]), b.chooseMove(b.sides[1], b.sides[0])}
+	order := [2]int{0, 1}
+	if b.goesSecond(moves) {
+		order = [2]int{1, 0}
+	}
+	events := []Event{}
+	for _, side := range order {
+		attacker, defender := b.sides[side], b.sides[1-side]
+		if attacker.Fainted() || defender.Fainted() {
+			break
+		}
+		events = append(events, b.useMove(attacker, defender, moves[side]))
+	}
+	return events
+}
+
+// goesSecond reports whether the first side moves after the second this turn
+func (b *Battle) goesSecond(moves [2]int) bool {
+	priorities := [2]int{}
+	for side, move := range moves {
+		if move >= 0 {
+			priorities[side] = b.sides[side].Moves[move].Priority
+		}
+	}
+	if priorities[0] != priorities[1] {
+		return priorities[0] < priorities[1]
+	}
+	speeds := [2]int{b.sides[0].Stats.Speed, b.sides[1].Stats.Speed}
+	if speeds[0] != speeds[1] {
+		return speeds[0] < speeds[1]
+	}
+	// Speed ties are settled with a coin toss
+	return b.rng.IntN(2) == 1
+}
+
+// chooseMove picks the move with PP left that should do the most damage,
+// or -1 for struggle if there is none
+func (b *Battle) chooseMove(attacker, defender *Combatant) int {
+	best, bestScore := -1, -1.0
+	for i, move := range attacker.Moves {
+		if move.PP <= 0 {
+			continue
+		}
+		multiplier, err := b.chart.Multiplier(move.Type, defender.Types...)
+		if err != nil {
+			multiplier = 1
+		}
+		score := float64(move.Power) * multiplier * stab(attacker, move)
+		if move.Accuracy > 0 {
+			score *= float64(move.Accuracy) / 100
+		}
+		if score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return best
+}
+
+func (b *Battle) useMove(attacker, defender *Combatant, index int) Event {
+	move := struggle
+	if index >= 0 {
+		attacker.Moves[index].PP--
+		move = attacker.Moves[index]
+	}
+	event := Event{Attacker: attacker.Name, Defender: defender.Name, Move: move.Name, Multiplier: 1}
+
+	if move.Type != "" {
+		multiplier, err := b.chart.Multiplier(move.Type, defender.Types...)
+		if err == nil {
+			event.Multiplier = multiplier
+		}
+	}
+	if move.Accuracy > 0 && b.rng.IntN(100) >= move.Accuracy {
+		event.Missed = true
+		return b.finish(event, attacker, defender)
+	}
+	if event.Multiplier == 0 {
+		return b.finish(event, attacker, defender)
+	}
+	event.Critical = b.rng.IntN(criticalChance) == 0
+	roll := minRoll + b.rng.IntN(maxRoll-minRoll+1)
+	event.Damage = min(Damage(*attacker, *defender, move, event.Multiplier, event.Critical, roll), defender.HP)
+	defender.HP -= event.Damage
+	if move.Name == struggle.Name {
+		event.Recoil = min(max(attacker.Stats.HP/4, 1), attacker.HP)
+		attacker.HP -= event.Recoil
+	}
+	return b.finish(event, attacker, defender)
+}
+
+// finish records the HP left and who fainted
+func (b *Battle) finish(event Event, attacker, defender *Combatant) Event {
+	event.AttackerHP, event.DefenderHP = attacker.HP, defender.HP
+	if defender.Fainted() {
+		event.Fainted = append(event.Fainted, defender.Name)
+	}
+	if attacker.Fainted() {
+		event.Fainted = append(event.Fainted, attacker.Name)
+	}
+	return event
+}
+
+// Damage is the damage move does, following the formula of the games from generation 5 on.
+// multiplier is the type effectiveness, and roll the random percentage between 85 and 100.
+func Damage(attacker, defender Combatant, move Move, multiplier float64, critical bool, roll int) int {
+	attack, defense := attacker.Stats.Attack, defender.Stats.Defense
+	if move.DamageClass == "special" {
+		attack, defense = attacker.Stats.SpecialAttack, defender.Stats.SpecialDefense
+	}
+	damage := float64((2*attacker.Level/5+2)*move.Power*attack/max(defense, 1)/50 + 2)
+	if critical {
+		damage = float64(int(damage * criticalBonus))
+	}
+	damage = float64(int(damage * float64(roll) / 100))
+	damage = float64(int(damage * stab(&attacker, move)))
+	damage = float64(int(damage * multiplier))
+	if multiplier > 0 {
+		return max(int(damage), 1)
+	}
+	return 0
+}
+
+func stab(attacker *Combatant, move Move) float64 {
+	for _, attackerType := range attacker.Types {
+		if attackerType == move.Type {
+			return stabBonus
+		}
+	}
+	return 1
+}
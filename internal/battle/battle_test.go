@@ -0,0 +1,163 @@
+package battle
+
+import (
+	"slices"
+	"testing"
+
+	pokeapi "github.com/avgra3/pokedexcli/internal/pokeapi"
+	pokeapitest "github.com/avgra3/pokedexcli/internal/pokeapitest"
+	random "github.com/avgra3/pokedexcli/internal/random"
+	typechart "github.com/avgra3/pokedexcli/internal/typechart"
+)
+
+// testPokemon returns a Pokemon of the default test dataset along with the moves it has learned
+func testPokemon(t *testing.T, name string, level int) (pokeapi.Pokemon, []pokeapi.Move) {
+	t.Helper()
+	dataset := pokeapitest.DefaultDataset()
+	moves := map[string]pokeapi.Move{}
+	for _, move := range dataset.Moves {
+		moves[move.Name] = move
+	}
+	for _, pokemon := range dataset.Pokemon {
+		if pokemon.Name != name {
+			continue
+		}
+		learned := []pokeapi.Move{}
+		for _, ref := range LearnableMoves(pokemon, level) {
+			learned = append(learned, moves[ref.Name])
+		}
+		return pokemon, learned
+	}
+	t.Fatalf("no %v in the test dataset", name)
+	return pokeapi.Pokemon{}, nil
+}
+
+func TestDamage(t *testing.T) {
+	// The example from Bulbapedia: a level 75 Glaceon's Ice Fang against a Garchomp
+	glaceon := Combatant{Level: 75, Types: []string{"ice"}, Stats: Stats{Attack: 123}}
+	garchomp := Combatant{Types: []string{"dragon", "ground"}, Stats: Stats{Defense: 163}}
+	iceFang := Move{Name: "ice-fang", Type: "ice", DamageClass: "physical", Power: 65}
+	cases := []struct {
+		roll     int
+		critical bool
+		expected int
+	}{
+		{roll: 85, expected: 168},
+		{roll: 100, expected: 196},
+		{roll: 100, critical: true, expected: 292},
+	}
+	for _, c := range cases {
+		actual := Damage(glaceon, garchomp, iceFang, 4, c.critical, c.roll)
+		if actual != c.expected {
+			t.Errorf("roll %v, critical %v: Expected: %v; Got: %v", c.roll, c.critical, c.expected, actual)
+		}
+	}
+	if actual := Damage(glaceon, garchomp, iceFang, 0, false, 100); actual != 0 {
+		t.Errorf("expected no damage against an immune defender; Got: %v", actual)
+	}
+	weak := Move{Name: "weak", Type: "normal", DamageClass: "physical", Power: 1}
+	if actual := Damage(Combatant{Level: 1, Stats: Stats{Attack: 1}}, garchomp, weak, 0.25, false, 85); actual != 1 {
+		t.Errorf("expected a hit to do at least 1 damage; Got: %v", actual)
+	}
+}
+
+func TestNewCombatant(t *testing.T) {
+	pokemon, moves := testPokemon(t, "pikachu", 50)
+	pikachu := NewCombatant(pokemon, moves, 50)
+	expected := Stats{HP: 102, Attack: 67, Defense: 52, SpecialAttack: 62, SpecialDefense: 62, Speed: 102}
+	if pikachu.Stats != expected || pikachu.HP != expected.HP {
+		t.Errorf("Expected: %+v; Got: %+v (%v HP)", expected, pikachu.Stats, pikachu.HP)
+	}
+	// Growl is a status move, the others come most recently learned first
+	names := []string{}
+	for _, move := range pikachu.Moves {
+		names = append(names, move.Name)
+	}
+	if len(names) != 3 || names[0] != "thunderbolt" || names[1] != "thunder-shock" || names[2] != "quick-attack" {
+		t.Errorf("unexpected moves: %v", names)
+	}
+
+	pokemon, moves = testPokemon(t, "pikachu", 5)
+	if young := NewCombatant(pokemon, moves, 5); len(young.Moves) != 2 {
+		t.Errorf("expected a level 5 pikachu not to know thunderbolt yet: %+v", young.Moves)
+	}
+}
+
+func TestBattle(t *testing.T) {
+	chart := typechart.New(pokeapitest.NewTypes())
+	pikachuPokemon, pikachuMoves := testPokemon(t, "pikachu", 10)
+	magikarpPokemon, magikarpMoves := testPokemon(t, "magikarp", 10)
+	pikachu := NewCombatant(pikachuPokemon, pikachuMoves, 10)
+	// Magikarp only knows splash at level 10, so it can only struggle
+	magikarp := NewCombatant(magikarpPokemon, magikarpMoves, 10)
+
+	battle := New(chart, pikachu, magikarp, random.New(1))
+	struggled := false
+	for !battle.Over() {
+		for _, event := range battle.Turn() {
+			if event.Attacker == "magikarp" {
+				struggled = struggled || (event.Move == "struggle" && event.Recoil > 0)
+			}
+			if event.Attacker == "pikachu" && event.Move == "thunder-shock" && !event.Missed && event.Multiplier != 2 {
+				t.Errorf("expected thunder-shock to be super effective against magikarp: %+v", event)
+			}
+		}
+	}
+	if !struggled {
+		t.Errorf("expected magikarp to struggle")
+	}
+	winner := battle.Winner()
+	if winner == nil || winner.Name != "pikachu" {
+		t.Errorf("expected pikachu to win; Got: %+v", winner)
+	}
+	if battle.Turn() != nil {
+		t.Errorf("expected no more turns once the battle is over")
+	}
+
+	// The battle spent PP on copies, so the combatants are ready for a rematch
+	fresh := NewCombatant(pikachuPokemon, pikachuMoves, 10)
+	if !slices.Equal(pikachu.Moves, fresh.Moves) {
+		t.Errorf("expected the battle to leave pikachu's PP alone; Expected: %+v; Got: %+v", fresh.Moves, pikachu.Moves)
+	}
+	spent := false
+	mine, _ := battle.Combatants()
+	for i, move := range mine.Moves {
+		spent = spent || move.PP < fresh.Moves[i].PP
+	}
+	if !spent {
+		t.Errorf("expected pikachu to have spent PP in the battle: %+v", mine.Moves)
+	}
+
+	// The same seed plays out the same battle
+	replay := New(chart, pikachu, magikarp, random.New(1))
+	for !replay.Over() {
+		replay.Turn()
+	}
+	if replay.TurnNumber() != battle.TurnNumber() {
+		t.Errorf("expected the same seed to take %v turns; Got: %v", battle.TurnNumber(), replay.TurnNumber())
+	}
+}
+
+func TestBattleDraw(t *testing.T) {
+	chart := typechart.New(pokeapitest.NewTypes())
+	// Two ghosts that only know a normal move can't hurt each other
+	ghost := Combatant{
+		Name:  "ghost",
+		Level: 10,
+		Types: []string{"ghost"},
+		Stats: Stats{HP: 30, Attack: 10, Defense: 10, Speed: 10},
+		HP:    30,
+		Moves: []Move{{Name: "tackle", Type: "normal", DamageClass: "physical", Power: 40, Accuracy: 100, PP: 1000}},
+	}
+	battle := New(chart, ghost, ghost, random.New(1))
+	for !battle.Over() {
+		for _, event := range battle.Turn() {
+			if event.Damage != 0 || event.Multiplier != 0 {
+				t.Fatalf("expected the ghosts to be immune: %+v", event)
+			}
+		}
+	}
+	if battle.TurnNumber() != MaxTurns || battle.Winner() != nil {
+		t.Errorf("expected a draw after %v turns; Got %v turns, winner %+v", MaxTurns, battle.TurnNumber(), battle.Winner())
+	}
+}
@@ -0,0 +1,141 @@
+package battle
+
+import (
+	"cmp"
+	"slices"
+
+	pokeapi "github.com/avgra3/pokedexcli/internal/pokeapi"
+	typechart "github.com/avgra3/pokedexcli/internal/typechart"
+)
+
+// MaxMoves is how many moves a Pokemon can know at once
+const MaxMoves = 4
+
+// averageIV stands in for the individual values the games roll for every stat
+const averageIV = 15
+
+// Stats are a Pokemon's stats at its level
+type Stats struct {
+	HP             int
+	Attack         int
+	Defense        int
+	SpecialAttack  int
+	SpecialDefense int
+	Speed          int
+}
+
+// Move is what a combatant needs to know about a move
+type Move struct {
+	Name string
+	Type string
+	// DamageClass is "physical", "special" or "status"
+	DamageClass string
+	Power       int
+	// Accuracy is a percentage; zero means the move never misses
+	Accuracy int
+	// PP is how many more times the move can be used
+	PP       int
+	Priority int
+}
+
+// Combatant is a Pokemon taking part in a battle
+type Combatant struct {
+	Name  string
+	Level int
+	Types []string
+	Stats Stats
+	// HP is what is left of Stats.HP
+	HP    int
+	Moves []Move
+}
+
+// struggle is used once a combatant has no other move left: typeless, it never misses,
+// and it hurts the user too
+var struggle = Move{Name: "struggle", DamageClass: "physical", Power: 50, PP: 1}
+
+// NewCombatant readies pokemon for battle at level with the damaging moves among moves.
+// Stats are worked out as the games do, for average IVs and no EVs.
+func NewCombatant(pokemon pokeapi.Pokemon, moves []pokeapi.Move, level int) Combatant {
+	combatant := Combatant{
+		Name:  pokemon.Name,
+		Level: level,
+		Types: typechart.PokemonTypes(pokemon, 0),
+	}
+	for _, stat := range pokemon.Stats {
+		value := (2*stat.BaseStat + averageIV) * level / 100
+		switch stat.Stat.Name {
+		case "hp":
+			combatant.Stats.HP = value + level + 10
+		case "attack":
+			combatant.Stats.Attack = value + 5
+		case "defense":
+			combatant.Stats.Defense = value + 5
+		case "special-attack":
+			combatant.Stats.SpecialAttack = value + 5
+		case "special-defense":
+			combatant.Stats.SpecialDefense = value + 5
+		case "speed":
+			combatant.Stats.Speed = value + 5
+		}
+	}
+	combatant.HP = combatant.Stats.HP
+	for _, move := range moves {
+		if !Damaging(move) {
+			continue
+		}
+		combatant.Moves = append(combatant.Moves, Move{
+			Name:        move.Name,
+			Type:        move.Type.Name,
+			DamageClass: move.DamageClass.Name,
+			Power:       move.Power,
+			Accuracy:    move.Accuracy,
+			PP:          move.Pp,
+			Priority:    move.Priority,
+		})
+		if len(combatant.Moves) == MaxMoves {
+			break
+		}
+	}
+	return combatant
+}
+
+// Damaging reports whether a move does damage, the only kind of move a combatant uses.
+// Status moves only have effects, which the battle doesn't model.
+func Damaging(move pokeapi.Move) bool {
+	return move.Power > 0 && move.DamageClass.Name != "status"
+}
+
+// LearnableMoves lists the moves pokemon learns by leveling up to level, most recently learned first.
+// Like a wild Pokemon, a combatant knows the last few of these.
+func LearnableMoves(pokemon pokeapi.Pokemon, level int) []pokeapi.NamedAPIResource[pokeapi.Move] {
+	type learnable struct {
+		move  pokeapi.NamedAPIResource[pokeapi.Move]
+		level int
+	}
+	moves := []learnable{}
+	for _, move := range pokemon.Moves {
+		learnedAt := -1
+		// Games disagree on the level, go with the earliest
+		for _, detail := range move.VersionGroupDetails {
+			if detail.MoveLearnMethod.Name == "level-up" && detail.LevelLearnedAt <= level && (learnedAt < 0 || detail.LevelLearnedAt < learnedAt) {
+				learnedAt = detail.LevelLearnedAt
+			}
+		}
+		if learnedAt >= 0 {
+			moves = append(moves, learnable{move: move.Move, level: learnedAt})
+		}
+	}
+	slices.SortStableFunc(moves, func(a, b learnable) int {
+		return cmp.Compare(b.level, a.level)
+	})
+	refs := []pokeapi.NamedAPIResource[pokeapi.Move]{}
+	for _, move := range moves {
+		refs = append(refs, move.move)
+	}
+	return refs
+}
+
+// Fainted reports whether the combatant can no longer fight
+func (c *Combatant) Fainted() bool {
+	return c.HP <= 0
+}
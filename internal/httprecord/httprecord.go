@@ -0,0 +1,192 @@
+// Package httprecord records HTTP responses into fixture files and replays them,
+// so code that talks to the PokeAPI can be tested without the network.
+package httprecord
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// RecordEnv is the environment variable that switches ModeFromEnv to Record
+const RecordEnv = "POKEDEXCLI_RECORD"
+
+type Mode int
+
+const (
+	// Replay serves responses from fixtures and fails requests that have none
+	Replay Mode = iota
+	// Record makes real requests and saves each response as a fixture
+	Record
+)
+
+// ModeFromEnv returns Record when POKEDEXCLI_RECORD is set to a non-empty value, Replay otherwise.
+// Refresh the fixtures of a package with: POKEDEXCLI_RECORD=1 go test ./...
+func ModeFromEnv() Mode {
+	if os.Getenv(RecordEnv) != "" {
+		return Record
+	}
+	return Replay
+}
+
+// Transport is an http.RoundTripper that records to or replays from Dir
+type Transport struct {
+	Mode Mode
+	Dir  string
+	// Next makes the real requests while recording; nil means http.DefaultTransport
+	Next http.RoundTripper
+}
+
+func New(dir string, mode Mode) *Transport {
+	return &Transport{Mode: mode, Dir: dir}
+}
+
+// Client returns an *http.Client using the transport
+func (t *Transport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// Fixture is a single recorded request/response pair, as stored on disk
+type Fixture struct {
+	Request  FixtureRequest  `json:"request"`
+	Response FixtureResponse `json:"response"`
+}
+
+type FixtureRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+type FixtureResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	// JSON bodies are stored as they are so fixtures stay readable, anything else as text
+	Body     json.RawMessage `json:"body,omitempty"`
+	BodyText string          `json:"body_text,omitempty"`
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	file := filepath.Join(t.Dir, FixtureName(req.Method, req.URL.String()))
+	if t.Mode == Record {
+		return t.record(req, file)
+	}
+	return t.replay(req, file)
+}
+
+func (t *Transport) replay(req *http.Request, file string) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	data, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("httprecord: no fixture for %v %v, record it with %v=1", req.Method, req.URL, RecordEnv)
+	}
+	if err != nil {
+		return nil, err
+	}
+	fixture := Fixture{}
+	err = json.Unmarshal(data, &fixture)
+	if err != nil {
+		return nil, fmt.Errorf("httprecord: %v: %w", file, err)
+	}
+	body := []byte(fixture.Response.Body)
+	if fixture.Response.BodyText != "" {
+		body = []byte(fixture.Response.BodyText)
+	}
+	return NewResponse(req, fixture.Response.StatusCode, fixture.Response.Header, body), nil
+}
+
+// NewResponse builds the response to req that a transport answers without the network,
+// as replays (and the PokeAPI mirror) do. A nil header means no headers.
+func NewResponse(req *http.Request, statusCode int, header http.Header, body []byte) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %v", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func (t *Transport) record(req *http.Request, file string) (*http.Response, error) {
+	next := t.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	res, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	// Hand the caller a fresh copy of the body we just consumed
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	fixture := Fixture{
+		Request:  FixtureRequest{Method: req.Method, URL: req.URL.String()},
+		Response: FixtureResponse{StatusCode: res.StatusCode, Header: recordedHeader(res.Header)},
+	}
+	if json.Valid(body) {
+		fixture.Response.Body = body
+	} else {
+		fixture.Response.BodyText = string(body)
+	}
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	err = os.MkdirAll(t.Dir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+	err = os.WriteFile(file, append(data, '\n'), 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// recordedHeader keeps only the headers that matter to our client, so fixtures don't churn
+func recordedHeader(header http.Header) http.Header {
+	kept := http.Header{}
+	for _, key := range []string{"Content-Type", "ETag", "Last-Modified", "Retry-After"} {
+		if value := header.Get(key); value != "" {
+			kept.Set(key, value)
+		}
+	}
+	return kept
+}
+
+var unsafeChars = regexp.MustCompile(`[^a-zA-Z0-9.-]+`)
+
+// FixtureName is the file a request is recorded to,
+// e.g. GET_pokeapi.co_api_v2_pokemon_pikachu.json
+func FixtureName(method, url string) string {
+	url = strings.TrimPrefix(strings.TrimPrefix(url, "https://"), "http://")
+	name := strings.Trim(unsafeChars.ReplaceAllString(method+"_"+url, "_"), "_")
+	// Keep names short enough for every file system, without losing uniqueness
+	if len(name) > 120 {
+		sum := sha256.Sum256([]byte(method + " " + url))
+		name = name[:100] + "_" + hex.EncodeToString(sum[:8])
+	}
+	return name + ".json"
+}
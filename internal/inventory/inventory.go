@@ -0,0 +1,79 @@
+// Package inventory keeps track of the items a trainer carries, such as balls
+package inventory
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrOutOfStock is returned when using an item the trainer has none of
+var ErrOutOfStock = errors.New("out of stock")
+
+// StarterKit is what a new trainer sets out with, by item name as on the PokeAPI
+func StarterKit() map[string]int {
+	return map[string]int{
+		"poke-ball":   20,
+		"great-ball":  10,
+		"ultra-ball":  5,
+		"master-ball": 1,
+		"dusk-ball":   3,
+		"quick-ball":  3,
+		"net-ball":    3,
+		"repeat-ball": 3,
+	}
+}
+
+// Inventory counts items by name. It is not safe for concurrent use.
+type Inventory struct {
+	counts map[string]int
+}
+
+// Entry is how many of one item the trainer holds
+type Entry struct {
+	Name  string
+	Count int
+}
+
+// New returns an inventory holding counts, e.g. New(StarterKit())
+func New(counts map[string]int) *Inventory {
+	inventory := &Inventory{counts: make(map[string]int)}
+	for name, count := range counts {
+		inventory.Add(name, count)
+	}
+	return inventory
+}
+
+// Count returns how many of an item the trainer holds
+func (i *Inventory) Count(name string) int {
+	return i.counts[strings.ToLower(name)]
+}
+
+// Add gives the trainer count more of an item
+func (i *Inventory) Add(name string, count int) {
+	name = strings.ToLower(name)
+	i.counts[name] = max(i.counts[name]+count, 0)
+	if i.counts[name] == 0 {
+		delete(i.counts, name)
+	}
+}
+
+// Use takes one of an item away, failing with ErrOutOfStock if there is none left
+func (i *Inventory) Use(name string) error {
+	if i.Count(name) == 0 {
+		return fmt.Errorf("%w: %v", ErrOutOfStock, name)
+	}
+	i.Add(name, -1)
+	return nil
+}
+
+// Entries lists every item the trainer holds, by name
+func (i *Inventory) Entries() []Entry {
+	entries := make([]Entry, 0, len(i.counts))
+	for name, count := range i.counts {
+		entries = append(entries, Entry{Name: name, Count: count})
+	}
+	slices.SortFunc(entries, func(a, b Entry) int { return strings.Compare(a.Name, b.Name) })
+	return entries
+}
@@ -0,0 +1,34 @@
+package inventory
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestInventory(t *testing.T) {
+	inventory := New(map[string]int{"poke-ball": 2, "master-ball": 1, "net-ball": 0})
+	expected := []Entry{{Name: "master-ball", Count: 1}, {Name: "poke-ball", Count: 2}}
+	if actual := inventory.Entries(); !slices.Equal(actual, expected) {
+		t.Errorf("Expected: %v; Got: %v", expected, actual)
+	}
+
+	for range 2 {
+		if err := inventory.Use("Poke-Ball"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := inventory.Use("poke-ball"); !errors.Is(err, ErrOutOfStock) {
+		t.Errorf("expected ErrOutOfStock; Got: %v", err)
+	}
+	if count := inventory.Count("poke-ball"); count != 0 {
+		t.Errorf("Expected: 0; Got: %v", count)
+	}
+
+	inventory.Add("ultra-ball", 3)
+	inventory.Add("ultra-ball", -5)
+	expected = []Entry{{Name: "master-ball", Count: 1}}
+	if actual := inventory.Entries(); !slices.Equal(actual, expected) {
+		t.Errorf("Expected: %v; Got: %v", expected, actual)
+	}
+}
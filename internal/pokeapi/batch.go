@@ -0,0 +1,73 @@
+package pokeapi
+
+import (
+	"context"
+	"sync"
+)
+
+// FetchResult is the outcome of fetching one item of a batch
+type FetchResult[T any] struct {
+	// Name is what the item was asked for by: a name, id or URL
+	Name  string
+	Value T
+	Err   error
+}
+
+// FetchMany gets many Pokemon by name or id, with up to concurrency requests in flight at once.
+// Results are in the same order as names, each with its own error. Fetches go through the cache
+// and rate limiter like any other, so repeated names are only requested once.
+func (c *Client) FetchMany(ctx context.Context, names []string, concurrency int) []FetchResult[Pokemon] {
+	return fanOut(ctx, names, concurrency, c.Pokemon)
+}
+
+// GetMany is Get for many urls at once, with up to concurrency requests in flight.
+// Results are in the same order as urls, each with its own error.
+func GetMany[T any](ctx context.Context, c *Client, urls []string, concurrency int) []FetchResult[T] {
+	return fanOut(ctx, urls, concurrency, func(ctx context.Context, url string) (T, error) {
+		return Get[T](ctx, c, url)
+	})
+}
+
+// ResolveAll resolves many references at once, with up to concurrency requests in flight.
+// Results are in the same order as refs and named after them, each with its own error.
+func ResolveAll[T any](ctx context.Context, c *Client, refs []NamedAPIResource[T], concurrency int) []FetchResult[T] {
+	urls := make([]string, len(refs))
+	for i, ref := range refs {
+		urls[i] = ref.URL
+	}
+	results := fanOut(ctx, urls, concurrency, func(ctx context.Context, url string) (T, error) {
+		return resolve[T](ctx, c, url)
+	})
+	for i := range results {
+		results[i].Name = refs[i].Name
+	}
+	return results
+}
+
+// fanOut calls fetch for every key on a pool of concurrency workers (at least one).
+// Once ctx is done the remaining keys are not fetched and fail with ctx's error.
+func fanOut[T any](ctx context.Context, keys []string, concurrency int, fetch func(context.Context, string) (T, error)) []FetchResult[T] {
+	results := make([]FetchResult[T], len(keys))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(max(concurrency, 1), len(keys)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i].Value, results[i].Err = fetch(ctx, keys[i])
+			}
+		}()
+	}
+	for i, key := range keys {
+		results[i].Name = key
+		if ctx.Err() != nil {
+			results[i].Err = ctx.Err()
+			continue
+		}
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,329 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	pokecache "github.com/avgra3/pokedexcli/internal/pokecache"
+)
+
+// DefaultBaseURL is the public PokeAPI used when no other base URL is given
+const DefaultBaseURL = "https://pokeapi.co/api/v2"
+
+// DefaultUserAgent is sent with every request unless overridden
+const DefaultUserAgent = "pokedexcli"
+
+// DefaultRequestTimeout bounds a single request unless overridden
+const DefaultRequestTimeout = 30 * time.Second
+
+// DefaultRateLimit and DefaultRateBurst keep us polite towards the public PokeAPI
+const (
+	DefaultRateLimit = 10.0
+	DefaultRateBurst = 10
+)
+
+// Client talks to a PokeAPI instance, caching raw responses by URL
+type Client struct {
+	baseURL        string
+	httpClient     *http.Client
+	cache          *pokecache.Cache
+	userAgent      string
+	requestTimeout time.Duration
+	retryPolicy    RetryPolicy
+	limiter        *rateLimiter
+	flights        flightGroup
+	stats          clientStats
+}
+
+// ClientStats counts what the client has done since it was created
+type ClientStats struct {
+	// Requests is the number of HTTP requests sent, including retries
+	Requests int64
+	// Retries is the number of requests that were repeated after a failure
+	Retries int64
+	// Throttled is the number of 429 Too Many Requests responses received
+	Throttled int64
+	// RateLimitWaits is the number of requests that had to wait for the rate limiter
+	RateLimitWaits int64
+	// Coalesced is the number of fetches that shared another caller's in-flight request
+	Coalesced int64
+	// Revalidated is the number of cached responses the server confirmed unchanged (304)
+	Revalidated int64
+}
+
+type clientStats struct {
+	requests       atomic.Int64
+	retries        atomic.Int64
+	throttled      atomic.Int64
+	rateLimitWaits atomic.Int64
+	coalesced      atomic.Int64
+	revalidated    atomic.Int64
+}
+
+// ClientOption configures a Client created by NewClient
+type ClientOption func(*Client)
+
+// WithBaseURL points the client at a different PokeAPI instance, e.g. a self-hosted mirror
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithHTTPClient replaces the default *http.Client
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithRequestTimeout bounds how long a single request may take; zero means no limit
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.requestTimeout = timeout
+	}
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithRateLimit allows perSecond requests on average with bursts of up to burst requests.
+// A perSecond of zero or less turns rate limiting off.
+func WithRateLimit(perSecond float64, burst int) ClientOption {
+	return func(c *Client) {
+		if perSecond <= 0 {
+			c.limiter = nil
+			return
+		}
+		c.limiter = newRateLimiter(perSecond, max(burst, 1))
+	}
+}
+
+// WithOffline answers every request from a mirror written by Client.Mirror, never touching the network
+func WithOffline(dir string) ClientOption {
+	return func(c *Client) {
+		c.httpClient = &http.Client{Transport: NewMirrorTransport(dir)}
+		// Nobody to be polite to, and nothing that could fail transiently
+		c.limiter = nil
+		c.retryPolicy = RetryPolicy{MaxAttempts: 1}
+	}
+}
+
+func NewClient(cache *pokecache.Cache, opts ...ClientOption) *Client {
+	client := &Client{
+		baseURL:        DefaultBaseURL,
+		httpClient:     &http.Client{},
+		cache:          cache,
+		userAgent:      DefaultUserAgent,
+		requestTimeout: DefaultRequestTimeout,
+		retryPolicy:    DefaultRetryPolicy,
+		limiter:        newRateLimiter(DefaultRateLimit, DefaultRateBurst),
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client
+}
+
+// Stats returns a snapshot of the client's counters
+func (c *Client) Stats() ClientStats {
+	return ClientStats{
+		Requests:       c.stats.requests.Load(),
+		Retries:        c.stats.retries.Load(),
+		Throttled:      c.stats.throttled.Load(),
+		RateLimitWaits: c.stats.rateLimitWaits.Load(),
+		Coalesced:      c.stats.coalesced.Load(),
+		Revalidated:    c.stats.revalidated.Load(),
+	}
+}
+
+// BaseURL returns the base URL requests are made against
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// ResourceURL builds the URL of a resource, e.g. ResourceURL("pokemon", "pikachu")
+func (c *Client) ResourceURL(resource string, name ...string) string {
+	parts := append([]string{c.baseURL, resource}, name...)
+	return strings.Join(parts, "/")
+}
+
+// LocationAreas gets a page of location areas. An empty pageURL returns the first page.
+func (c *Client) LocationAreas(ctx context.Context, pageURL string) (NamedAPIResourceList[LocationArea], error) {
+	if pageURL == "" {
+		pageURL = c.ResourceURL("location-area")
+	}
+	return Get[NamedAPIResourceList[LocationArea]](ctx, c, pageURL)
+}
+
+// LocationArea gets a single location area by name or id
+func (c *Client) LocationArea(ctx context.Context, name string) (LocationArea, error) {
+	return Get[LocationArea](ctx, c, c.ResourceURL("location-area", name))
+}
+
+// Names lists the name of every resource of a kind, e.g. Names("pokemon")
+func (c *Client) Names(ctx context.Context, resource string) ([]string, error) {
+	// The list endpoints accept a limit large enough to return everything at once
+	listResults, err := Get[NamedAPIResourceList[any]](ctx, c, c.ResourceURL(resource)+"?limit=100000")
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(listResults.Results))
+	for _, result := range listResults.Results {
+		names = append(names, result.Name)
+	}
+	return names, nil
+}
+
+// Pokemon gets a single Pokemon by name or id
+func (c *Client) Pokemon(ctx context.Context, name string) (Pokemon, error) {
+	return Get[Pokemon](ctx, c, c.ResourceURL("pokemon", name))
+}
+
+// fetch returns the raw body for url, from the cache when possible.
+// Stale cache entries are served as they are and refreshed in the background.
+// Concurrent fetches of the same url share a single request.
+func (c *Client) fetch(ctx context.Context, url string) ([]byte, error) {
+	// Check cache has data
+	cached, freshness, ok := c.cache.Lookup(url)
+	if ok && freshness == pokecache.Fresh {
+		return cached.Val, nil
+	}
+	if ok && freshness == pokecache.Stale {
+		// Almost certainly still valid, so don't make the caller wait for the check
+		go c.revalidate(url, cached)
+		return cached.Val, nil
+	}
+
+	// Cache has no (usable) data, call the api
+	for {
+		body, err, shared := c.flights.do(ctx, url, func() ([]byte, error) {
+			return c.fetchUncached(ctx, url, cached)
+		})
+		if !shared {
+			return body, err
+		}
+		c.stats.coalesced.Add(1)
+		// The caller we waited on gave up; that is no reason for us to, so try again ourselves
+		if err != nil && ctx.Err() == nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+			continue
+		}
+		return body, err
+	}
+}
+
+// revalidate refreshes a stale cache entry, ignoring failures: the entry just stays stale
+func (c *Client) revalidate(url string, cached pokecache.Entry) {
+	ctx := context.Background()
+	c.flights.do(ctx, url, func() ([]byte, error) {
+		return c.fetchUncached(ctx, url, cached)
+	})
+}
+
+// fetchUncached calls the api, retrying failures, and caches what it gets.
+// If cached has validators the request is conditional, and a 304 keeps the cached body.
+func (c *Client) fetchUncached(ctx context.Context, url string, cached pokecache.Entry) ([]byte, error) {
+	for retry := 0; ; retry++ {
+		res, err := c.attempt(ctx, url, cached)
+		if err == nil {
+			if res.notModified {
+				c.stats.revalidated.Add(1)
+				// Still current, it only needs to count as fresh again
+				if !c.cache.Touch(url) {
+					c.cache.AddEntry(url, pokecache.Entry{Val: cached.Val, ETag: cached.ETag, LastModified: cached.LastModified})
+				}
+				return cached.Val, nil
+			}
+			// Only successful responses are worth caching
+			c.cache.AddEntry(url, pokecache.Entry{Val: res.body, ETag: res.etag, LastModified: res.lastModified})
+			return res.body, nil
+		}
+		if retry+1 >= c.retryPolicy.MaxAttempts || !retryable(ctx, err) {
+			return nil, err
+		}
+		c.stats.retries.Add(1)
+		if sleepErr := sleep(ctx, c.retryPolicy.delay(retry, err)); sleepErr != nil {
+			// Being cancelled is what the caller needs to hear about, the failure is just context
+			return nil, errors.Join(sleepErr, err)
+		}
+	}
+}
+
+// response is what we keep of a successful attempt
+type response struct {
+	body         []byte
+	etag         string
+	lastModified string
+	// notModified is set when the server answered a conditional request with 304
+	notModified bool
+}
+
+// attempt makes a single request, waiting for the rate limiter first
+func (c *Client) attempt(ctx context.Context, url string, cached pokecache.Entry) (response, error) {
+	if c.limiter != nil {
+		waited, err := c.limiter.wait(ctx)
+		if waited {
+			c.stats.rateLimitWaits.Add(1)
+		}
+		if err != nil {
+			return response{}, err
+		}
+	}
+	if c.requestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return response{}, err
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	if cached.ETag != "" {
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+	if cached.LastModified != "" {
+		req.Header.Set("If-Modified-Since", cached.LastModified)
+	}
+	c.stats.requests.Add(1)
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return response{}, err
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return response{}, err
+	}
+
+	if res.StatusCode == http.StatusNotModified && cached.Val != nil {
+		return response{notModified: true}, nil
+	}
+	if res.StatusCode > 299 {
+		if res.StatusCode == http.StatusTooManyRequests {
+			c.stats.throttled.Add(1)
+		}
+		return response{}, &HTTPStatusError{StatusCode: res.StatusCode, URL: url, Header: res.Header, Body: body}
+	}
+	return response{
+		body:         body,
+		etag:         res.Header.Get("ETag"),
+		lastModified: res.Header.Get("Last-Modified"),
+	}, nil
+}
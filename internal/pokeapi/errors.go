@@ -0,0 +1,44 @@
+package pokeapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrNotFound is matched (via errors.Is) by any error for a resource the PokeAPI does not have
+var ErrNotFound = errors.New("pokeapi: resource not found")
+
+// ErrNoMorePages is returned by a Paginator asked to move past the first or last page
+var ErrNoMorePages = errors.New("pokeapi: no more pages")
+
+// HTTPStatusError is returned when the PokeAPI responds with a non-2xx status
+type HTTPStatusError struct {
+	StatusCode int
+	URL        string
+	Header     http.Header
+	Body       []byte
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("pokeapi: %v responded with status %d", e.URL, e.StatusCode)
+}
+
+// Is lets errors.Is(err, ErrNotFound) match 404 responses
+func (e *HTTPStatusError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// DecodeError is returned when a response body is not the JSON we expected
+type DecodeError struct {
+	URL string
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("pokeapi: could not decode response from %v: %v", e.URL, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
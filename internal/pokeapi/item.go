@@ -0,0 +1,32 @@
+package pokeapi
+
+import (
+	"context"
+	"strings"
+)
+
+// Item gets a single item by name or id, e.g. Item("great-ball")
+func (c *Client) Item(ctx context.Context, name string) (Item, error) {
+	return Get[Item](ctx, c, c.ResourceURL("item", name))
+}
+
+// LocalizedName returns the item's name (e.g. "Great Ball") in language,
+// falling back to DefaultLanguage, or its API name if neither is available
+func (i Item) LocalizedName(language string) string {
+	name, ok := localized(i.Names, language, func(name Name) (string, string) {
+		return name.Language.Name, name.Name
+	})
+	if !ok {
+		return i.Name
+	}
+	return name
+}
+
+// FlavorText returns the item's most recent in-game description in language, falling back
+// to DefaultLanguage, or an empty string if neither is available
+func (i Item) FlavorText(language string) string {
+	text, _ := localized(i.FlavorTextEntries, language, func(entry VersionGroupFlavorText) (string, string) {
+		return entry.Language.Name, entry.Text
+	})
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,196 @@
+package pokeapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	atomicfile "github.com/avgra3/pokedexcli/internal/atomicfile"
+	httprecord "github.com/avgra3/pokedexcli/internal/httprecord"
+)
+
+// A mirror is a directory with one sub directory per resource kind, e.g.
+//
+//	data/pokemon/index.json  every pokemon, in the format of the list endpoint
+//	data/pokemon/25.json     a single pokemon, as returned by the API
+//
+// Mirror writes one and NewMirrorTransport serves it back over HTTP.
+
+const mirrorIndex = "index.json"
+
+// mirrorPageSize is how many resources we ask for per page while walking a list endpoint
+const mirrorPageSize = 100
+
+// MirrorProgress is called after each resource is saved
+type MirrorProgress func(resource string, done, total int)
+
+// Mirror downloads every resource of the given kinds (e.g. "pokemon", "location-area") into dir.
+// Resources already in dir are skipped, so an interrupted mirror can be resumed.
+func (c *Client) Mirror(ctx context.Context, dir string, resources []string, progress MirrorProgress) error {
+	for _, resource := range resources {
+		err := c.mirrorResource(ctx, dir, resource, progress)
+		if err != nil {
+			return fmt.Errorf("mirroring %v: %w", resource, err)
+		}
+	}
+	return nil
+}
+
+func (c *Client) mirrorResource(ctx context.Context, dir, resource string, progress MirrorProgress) error {
+	resourceDir := filepath.Join(dir, resource)
+	err := os.MkdirAll(resourceDir, 0o755)
+	if err != nil {
+		return err
+	}
+
+	// Walk the list endpoint page by page, following the Next links
+	index := NamedAPIResourceList[any]{}
+	pageURL := fmt.Sprintf("%v?limit=%d", c.ResourceURL(resource), mirrorPageSize)
+	for pageURL != "" {
+		page, err := Get[NamedAPIResourceList[any]](ctx, c, pageURL)
+		if err != nil {
+			return err
+		}
+		index.Results = append(index.Results, page.Results...)
+		pageURL = page.Next
+	}
+	index.Count = len(index.Results)
+
+	for i, result := range index.Results {
+		id := resourceID(result.URL)
+		if id == "" {
+			return fmt.Errorf("no id in %v", result.URL)
+		}
+		file := filepath.Join(resourceDir, id+".json")
+		if _, err := os.Stat(file); err != nil {
+			body, err := c.fetch(ctx, result.URL)
+			if err != nil {
+				return err
+			}
+			err = atomicfile.WriteFile(file, body)
+			if err != nil {
+				return err
+			}
+		}
+		if progress != nil {
+			progress(resource, i+1, index.Count)
+		}
+	}
+
+	// The index goes last: a mirror with an index is a complete mirror
+	data, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(filepath.Join(resourceDir, mirrorIndex), data)
+}
+
+// resourceID returns the trailing id of a resource URL, e.g. "25" for .../pokemon/25/
+func resourceID(url string) string {
+	return path.Base(strings.TrimSuffix(url, "/"))
+}
+
+// MirrorTransport answers PokeAPI requests from a directory written by Mirror,
+// without touching the network. Only the path of a request matters, not its host.
+type MirrorTransport struct {
+	Dir string
+}
+
+// NewMirrorTransport serves the mirror in dir
+func NewMirrorTransport(dir string) *MirrorTransport {
+	return &MirrorTransport{Dir: dir}
+}
+
+func (t *MirrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	if req.Method != http.MethodGet {
+		return mirrorResponse(req, http.StatusMethodNotAllowed, []byte("the mirror is read-only")), nil
+	}
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	last := segments[len(segments)-1]
+	// A path ending in a mirrored resource kind is a list request, anything else names a single resource
+	if t.mirrored(last) {
+		return t.list(req, last)
+	}
+	if len(segments) < 2 || !t.mirrored(segments[len(segments)-2]) {
+		return mirrorResponse(req, http.StatusNotFound, []byte("not in the mirror")), nil
+	}
+	return t.resource(req, segments[len(segments)-2], last)
+}
+
+func (t *MirrorTransport) mirrored(resource string) bool {
+	info, err := os.Stat(filepath.Join(t.Dir, resource, mirrorIndex))
+	return resource != "" && err == nil && !info.IsDir()
+}
+
+func (t *MirrorTransport) index(resource string) (NamedAPIResourceList[any], error) {
+	index := NamedAPIResourceList[any]{}
+	data, err := os.ReadFile(filepath.Join(t.Dir, resource, mirrorIndex))
+	if err != nil {
+		return index, err
+	}
+	err = json.Unmarshal(data, &index)
+	return index, err
+}
+
+// list serves a page of the list endpoint, honoring offset and limit like the real API
+func (t *MirrorTransport) list(req *http.Request, resource string) (*http.Response, error) {
+	index, err := t.index(resource)
+	if err != nil {
+		return nil, err
+	}
+	page := ListPage(req.URL.Query(), index.Results, func(offset, limit int) string {
+		u := *req.URL
+		u.RawQuery = fmt.Sprintf("offset=%d&limit=%d", offset, limit)
+		return u.String()
+	})
+	data, err := json.Marshal(page)
+	if err != nil {
+		return nil, err
+	}
+	return mirrorResponse(req, http.StatusOK, data), nil
+}
+
+// resource serves a single resource by id or name
+func (t *MirrorTransport) resource(req *http.Request, resource, name string) (*http.Response, error) {
+	id := name
+	if _, err := strconv.Atoi(name); err != nil {
+		index, err := t.index(resource)
+		if err != nil {
+			return nil, err
+		}
+		id = ""
+		for _, result := range index.Results {
+			if result.Name == name {
+				id = resourceID(result.URL)
+				break
+			}
+		}
+	}
+	data, err := os.ReadFile(filepath.Join(t.Dir, resource, id+".json"))
+	if id == "" || errors.Is(err, os.ErrNotExist) {
+		return mirrorResponse(req, http.StatusNotFound, []byte("not in the mirror")), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mirrorResponse(req, http.StatusOK, data), nil
+}
+
+func mirrorResponse(req *http.Request, statusCode int, body []byte) *http.Response {
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	if statusCode != http.StatusOK {
+		header.Set("Content-Type", "text/plain")
+	}
+	return httprecord.NewResponse(req, statusCode, header, body)
+}
@@ -0,0 +1,181 @@
+package pokeapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// DefaultPageSize is how many results the list endpoints return unless asked otherwise
+const DefaultPageSize = 20
+
+// NamedAPIResourceList is one page of a list endpoint, e.g. /pokemon or /location-area
+type NamedAPIResourceList[T any] struct {
+	Count    int                   `json:"count"`
+	Next     string                `json:"next"`
+	Previous string                `json:"previous"`
+	Results  []NamedAPIResource[T] `json:"results"`
+}
+
+// MarshalJSON writes missing next/previous links as null, as the API does
+func (l NamedAPIResourceList[T]) MarshalJSON() ([]byte, error) {
+	link := func(url string) *string {
+		if url == "" {
+			return nil
+		}
+		return &url
+	}
+	results := l.Results
+	if results == nil {
+		results = []NamedAPIResource[T]{}
+	}
+	return json.Marshal(struct {
+		Count    int                   `json:"count"`
+		Next     *string               `json:"next"`
+		Previous *string               `json:"previous"`
+		Results  []NamedAPIResource[T] `json:"results"`
+	}{Count: l.Count, Next: link(l.Next), Previous: link(l.Previous), Results: results})
+}
+
+// ListPage cuts the page a list request asks for out of results, honoring its offset and
+// limit query parameters like the API does. pageURL builds the link to the page at offset.
+// It is for serving list endpoints without the API, e.g. from a mirror.
+func ListPage[T any](query url.Values, results []NamedAPIResource[T], pageURL func(offset, limit int) string) NamedAPIResourceList[T] {
+	offset, err := strconv.Atoi(query.Get("offset"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = DefaultPageSize
+	}
+	start := min(offset, len(results))
+	end := min(offset+limit, len(results))
+
+	page := NamedAPIResourceList[T]{Count: len(results), Results: results[start:end]}
+	if end < len(results) {
+		page.Next = pageURL(end, limit)
+	}
+	if start > 0 {
+		page.Previous = pageURL(max(start-limit, 0), limit)
+	}
+	return page
+}
+
+// Paginator pages through a list endpoint, remembering where it is.
+// It moves with the next/previous links the API returns, so it works for any list endpoint.
+type Paginator[T any] struct {
+	client   *Client
+	resource string
+	pageSize int
+	current  NamedAPIResourceList[T]
+	// number of the current page, starting from 0; -1 until the first page is fetched
+	number int
+}
+
+// NewPaginator pages through the list of resource (e.g. "pokemon"), pageSize results at a time.
+// A pageSize of zero or less means DefaultPageSize.
+func NewPaginator[T any](c *Client, resource string, pageSize int) *Paginator[T] {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	return &Paginator[T]{client: c, resource: resource, pageSize: pageSize, number: -1}
+}
+
+// Current returns the page last moved to, which is empty before the first move
+func (p *Paginator[T]) Current() NamedAPIResourceList[T] {
+	return p.current
+}
+
+// Number returns the number of the current page, starting from 0, or -1 before the first move
+func (p *Paginator[T]) Number() int {
+	return p.number
+}
+
+// Next moves to the next page, or the first page before any move.
+// After the last page it returns ErrNoMorePages and stays where it is.
+func (p *Paginator[T]) Next(ctx context.Context) (NamedAPIResourceList[T], error) {
+	if p.number < 0 {
+		return p.Page(ctx, 0)
+	}
+	if p.current.Next == "" {
+		return p.current, ErrNoMorePages
+	}
+	return p.move(ctx, p.current.Next, p.number+1)
+}
+
+// Prev moves to the previous page. On the first page (or before any move) it returns
+// ErrNoMorePages and stays where it is.
+func (p *Paginator[T]) Prev(ctx context.Context) (NamedAPIResourceList[T], error) {
+	if p.number <= 0 || p.current.Previous == "" {
+		return p.current, ErrNoMorePages
+	}
+	return p.move(ctx, p.current.Previous, p.number-1)
+}
+
+// Page jumps to page n, starting from 0. Past the last page it returns ErrNoMorePages.
+func (p *Paginator[T]) Page(ctx context.Context, n int) (NamedAPIResourceList[T], error) {
+	if n < 0 {
+		return p.current, ErrNoMorePages
+	}
+	if p.number >= 0 && n*p.pageSize >= max(p.current.Count, 1) {
+		return p.current, ErrNoMorePages
+	}
+	page, err := Get[NamedAPIResourceList[T]](ctx, p.client, p.pageURL(n))
+	if err != nil {
+		return page, err
+	}
+	if n > 0 && len(page.Results) == 0 {
+		return p.current, ErrNoMorePages
+	}
+	p.current = page
+	p.number = n
+	return page, nil
+}
+
+// All iterates over every resource in the list, from the first page to the last.
+// It fetches pages as it goes and does not move the paginator. On failure it yields the
+// error and stops.
+func (p *Paginator[T]) All(ctx context.Context) iter.Seq2[NamedAPIResource[T], error] {
+	return func(yield func(NamedAPIResource[T], error) bool) {
+		pageURL := p.pageURL(0)
+		for pageURL != "" {
+			page, err := Get[NamedAPIResourceList[T]](ctx, p.client, pageURL)
+			if err != nil {
+				yield(NamedAPIResource[T]{}, err)
+				return
+			}
+			for _, result := range page.Results {
+				if !yield(result, nil) {
+					return
+				}
+			}
+			pageURL = page.Next
+		}
+	}
+}
+
+// move fetches the page at pageURL and makes it the current page number n.
+// A failed fetch leaves the paginator where it was.
+func (p *Paginator[T]) move(ctx context.Context, pageURL string, n int) (NamedAPIResourceList[T], error) {
+	page, err := Get[NamedAPIResourceList[T]](ctx, p.client, pageURL)
+	if err != nil {
+		return page, err
+	}
+	p.current = page
+	p.number = n
+	return page, nil
+}
+
+// pageURL builds the URL of page n. The first page at the default size is the bare
+// list URL, so that it shares its cache entry with LocationAreas and friends.
+func (p *Paginator[T]) pageURL(n int) string {
+	listURL := p.client.ResourceURL(p.resource)
+	if n == 0 && p.pageSize == DefaultPageSize {
+		return listURL
+	}
+	return fmt.Sprintf("%v?offset=%d&limit=%d", listURL, n*p.pageSize, p.pageSize)
+}
@@ -1,12 +1,705 @@
 package pokeapi
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	httprecord "github.com/avgra3/pokedexcli/internal/httprecord"
+	pokecache "github.com/avgra3/pokedexcli/internal/pokecache"
 )
 
+// newFixtureClient returns a client backed by the fixtures in testdata/fixtures.
+// Run the tests with POKEDEXCLI_RECORD=1 to refresh them from the real PokeAPI.
+func newFixtureClient() *Client {
+	recorder := httprecord.New("testdata/fixtures", httprecord.ModeFromEnv())
+	return NewClient(
+		pokecache.NewCache(),
+		WithHTTPClient(recorder.Client()),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 1}),
+	)
+}
+
 func TestGetLocations(t *testing.T) {
-	// Setup of test case structs
-	fmt.Println("Not implemented yet...")
+	client := newFixtureClient()
+	firstPage, err := client.LocationAreas(context.Background(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(firstPage.Results) != 20 || firstPage.Results[0].Name != "canalave-city-area" {
+		t.Errorf("unexpected first page: %+v", firstPage.Results)
+	}
+	if firstPage.Next == "" || firstPage.Previous != "" {
+		t.Errorf("expected only a next page; Got next %q, previous %q", firstPage.Next, firstPage.Previous)
+	}
+
+	secondPage, err := client.LocationAreas(context.Background(), firstPage.Next)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(secondPage.Results) != 20 || secondPage.Results[0].Name != "mt-coronet-b1f" {
+		t.Errorf("unexpected second page: %+v", secondPage.Results)
+	}
+	if secondPage.Previous == "" {
+		t.Errorf("expected a previous page")
+	}
+}
+
+func TestGetLocationAreas(t *testing.T) {
+	client := newFixtureClient()
+	locationArea, err := client.LocationArea(context.Background(), "canalave-city-area")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if locationArea.Location.Name != "canalave-city" {
+		t.Errorf("unexpected location: %+v", locationArea.Location)
+	}
+	expected := []string{"tentacool", "tentacruel", "staryu", "magikarp", "gyarados", "wingull", "pelipper", "shellos", "gastrodon", "finneon", "lumineon"}
+	if len(locationArea.PokemonEncounters) != len(expected) {
+		t.Fatalf("Length of actual (%v) does not equal expected (%v)", len(locationArea.PokemonEncounters), len(expected))
+	}
+	for i, encounter := range locationArea.PokemonEncounters {
+		if encounter.Pokemon.Name != expected[i] {
+			t.Errorf("Expected: %v; Got: %v", expected[i], encounter.Pokemon.Name)
+		}
+	}
+}
+
+func TestGetPokemon(t *testing.T) {
+	client := newFixtureClient()
+	pokemon, err := client.Pokemon(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pokemon.Id != 25 || pokemon.BaseExperience != 112 || pokemon.Height != 4 || pokemon.Weight != 60 {
+		t.Errorf("unexpected pokemon: %+v", pokemon)
+	}
+	if len(pokemon.Stats) != 6 || pokemon.Stats[5].Stat.Name != "speed" || pokemon.Stats[5].BaseStat != 90 {
+		t.Errorf("unexpected stats: %+v", pokemon.Stats)
+	}
+	if len(pokemon.Types) != 1 || pokemon.Types[0].Type.Name != "electric" {
+		t.Errorf("unexpected types: %+v", pokemon.Types)
+	}
+
+	_, err = client.Pokemon(context.Background(), "pikachuu")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound; Got: %v", err)
+	}
+}
+
+func TestGetPokemonSpecies(t *testing.T) {
+	client := newFixtureClient()
+	pokemon, err := client.Pokemon(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	species, err := pokemon.Species.Resolve(context.Background(), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if species.Id != 25 || species.CaptureRate != 190 || species.IsLegendary || species.Habitat.Name != "forest" || len(species.EggGroups) != 2 {
+		t.Errorf("unexpected species: %+v", species)
+	}
+
+	cases := []struct {
+		language   string
+		genus      string
+		flavorText string
+	}{
+		{language: "en", genus: "Mouse Pokémon", flavorText: "It occasionally uses an electric shock to recharge a fellow Pikachu that is in a weakened state."},
+		{language: "fr", genus: "Pokémon Souris", flavorText: "Il lui arrive de remettre en marche un Pikachu évanoui en lui envoyant une décharge électrique."},
+		// Not available, so English it is
+		{language: "de", genus: "Mouse Pokémon", flavorText: "It occasionally uses an electric shock to recharge a fellow Pikachu that is in a weakened state."},
+	}
+	for _, c := range cases {
+		if genus := species.Genus(c.language); genus != c.genus {
+			t.Errorf("Genus(%v): Expected: %v; Got: %v", c.language, c.genus, genus)
+		}
+		if flavorText := species.FlavorText(c.language); flavorText != c.flavorText {
+			t.Errorf("FlavorText(%v): Expected: %v; Got: %v", c.language, c.flavorText, flavorText)
+		}
+	}
+}
+
+func TestItemText(t *testing.T) {
+	english := NamedAPIResource[Language]{Name: "en"}
+	french := NamedAPIResource[Language]{Name: "fr"}
+	item := Item{
+		Name:  "great-ball",
+		Names: []Name{{Name: "Great Ball", Language: english}, {Name: "Super Ball", Language: french}},
+		FlavorTextEntries: []VersionGroupFlavorText{
+			{Text: "A good ball.", Language: english},
+			{Text: "A good, high-performance\nPoké Ball.", Language: english},
+		},
+	}
+	cases := []struct {
+		language   string
+		name       string
+		flavorText string
+	}{
+		{language: "en", name: "Great Ball", flavorText: "A good, high-performance Poké Ball."},
+		{language: "fr", name: "Super Ball", flavorText: "A good, high-performance Poké Ball."},
+		{language: "ja", name: "Great Ball", flavorText: "A good, high-performance Poké Ball."},
+	}
+	for _, c := range cases {
+		if name := item.LocalizedName(c.language); name != c.name {
+			t.Errorf("LocalizedName(%v): Expected: %v; Got: %v", c.language, c.name, name)
+		}
+		if flavorText := item.FlavorText(c.language); flavorText != c.flavorText {
+			t.Errorf("FlavorText(%v): Expected: %v; Got: %v", c.language, c.flavorText, flavorText)
+		}
+	}
+	if name := (Item{Name: "net-ball"}).LocalizedName("en"); name != "net-ball" {
+		t.Errorf("expected the API name without any names; Got: %v", name)
+	}
+}
+
+func TestClientPokemon(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/api/v2/pokemon/pikachu" {
+			t.Errorf("unexpected path: %v", r.URL.Path)
+		}
+		if r.Header.Get("User-Agent") != "pokedexcli-test" {
+			t.Errorf("unexpected User-Agent: %v", r.Header.Get("User-Agent"))
+		}
+		fmt.Fprint(w, `{"id": 25, "name": "pikachu", "base_experience": 112}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		pokecache.NewCache(),
+		WithBaseURL(server.URL+"/api/v2/"),
+		WithHTTPClient(server.Client()),
+		WithUserAgent("pokedexcli-test"),
+	)
+	for i := 0; i < 2; i++ {
+		pokemon, err := client.Pokemon(context.Background(), "pikachu")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if pokemon.Name != "pikachu" || pokemon.BaseExperience != 112 {
+			t.Errorf("unexpected pokemon: %+v", pokemon)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, the second should be cached; got %v", requests)
+	}
+}
+
+func TestClientErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pokemon/pikachuu":
+			http.NotFound(w, r)
+		case "/pokemon/broken":
+			fmt.Fprint(w, `{"name": `)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+	client := NewClient(pokecache.NewCache(), WithBaseURL(server.URL), WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+
+	_, err := client.Pokemon(context.Background(), "pikachuu")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound; Got: %v", err)
+	}
+
+	_, err = client.Pokemon(context.Background(), "broken")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("expected *DecodeError; Got: %v", err)
+	}
+
+	_, err = client.Pokemon(context.Background(), "pikachu")
+	var statusErr *HTTPStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected *HTTPStatusError with status 500; Got: %v", err)
+	}
+	if errors.Is(err, ErrNotFound) {
+		t.Errorf("a 500 should not match ErrNotFound")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pokemon/pikachu":
+			fmt.Fprintf(w, `{"name": "pikachu", "species": {"name": "pikachu", "url": "%v/pokemon-species/25/"},
+				"types": [{"slot": 1, "type": {"name": "electric", "url": "%v/type/13/"}}]}`, server.URL, server.URL)
+		case "/pokemon-species/25/":
+			fmt.Fprint(w, `{"id": 25, "name": "pikachu", "capture_rate": 190,
+				"evolution_chain": {"url": "https://pokeapi.co/api/v2/evolution-chain/10/"}}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	client := NewClient(pokecache.NewCache(), WithBaseURL(server.URL))
+
+	pokemon, err := client.Pokemon(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pokemon.Types) != 1 || pokemon.Types[0].Type.Name != "electric" {
+		t.Errorf("expected type reference to decode; Got: %+v", pokemon.Types)
+	}
+	species, err := pokemon.Species.Resolve(context.Background(), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if species.CaptureRate != 190 || species.EvolutionChain.URL == "" {
+		t.Errorf("unexpected species: %+v", species)
+	}
+
+	empty := NamedAPIResource[PokemonHabitat]{}
+	_, err = empty.Resolve(context.Background(), client)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound resolving an empty reference; Got: %v", err)
+	}
+}
+
+func TestClientContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewClient(pokecache.NewCache(), WithBaseURL(server.URL))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := client.Pokemon(ctx, "pikachu")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled; Got: %v", err)
+	}
+
+	client = NewClient(pokecache.NewCache(), WithBaseURL(server.URL),
+		WithRequestTimeout(10*time.Millisecond), WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+	_, err = client.Pokemon(context.Background(), "pikachu")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded; Got: %v", err)
+	}
+}
+
+func TestClientRetry(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch requests {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			fmt.Fprint(w, `{"name": "pikachu"}`)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		pokecache.NewCache(),
+		WithBaseURL(server.URL),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}),
+		WithRateLimit(50, 1),
+	)
+	pokemon, err := client.Pokemon(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pokemon.Name != "pikachu" {
+		t.Errorf("unexpected pokemon: %+v", pokemon)
+	}
+	stats := client.Stats()
+	if stats.Requests != 3 || stats.Retries != 2 || stats.Throttled != 1 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+	if stats.RateLimitWaits == 0 {
+		t.Errorf("expected the rate limiter to hold back at least one request: %+v", stats)
+	}
+}
+
+func TestClientRetryCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		// Cancel once the client is waiting out the Retry-After
+		time.AfterFunc(50*time.Millisecond, cancel)
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		pokecache.NewCache(),
+		WithBaseURL(server.URL),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Minute}),
+		WithRateLimit(0, 0),
+	)
+	start := time.Now()
+	_, err := client.Pokemon(ctx, "pikachu")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the cancellation to be reported; Got: %v", err)
+	}
+	var statusErr *HTTPStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected the last failure to be kept too; Got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected cancelling to cut the wait short; took %v", elapsed)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		input    string
+		expected time.Duration
+		ok       bool
+	}{
+		{input: "", expected: 0, ok: false},
+		{input: "3", expected: 3 * time.Second, ok: true},
+		{input: "Mon, 01 Jan 2024 12:00:10 GMT", expected: 10 * time.Second, ok: true},
+		{input: "Mon, 01 Jan 2024 11:00:00 GMT", expected: 0, ok: true},
+		{input: "soon", expected: 0, ok: false},
+	}
+	for _, c := range cases {
+		actual, ok := parseRetryAfter(c.input, now)
+		if actual != c.expected || ok != c.ok {
+			t.Errorf("parseRetryAfter(%q): Expected: %v (%v); Got: %v (%v)", c.input, c.expected, c.ok, actual, ok)
+		}
+	}
+}
+
+func TestClientCoalescing(t *testing.T) {
+	var requests atomic.Int64
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		fmt.Fprint(w, `{"name": "pikachu"}`)
+	}))
+	defer server.Close()
+	cache := pokecache.NewCache()
+	client := NewClient(cache, WithBaseURL(server.URL))
+
+	const callers = 5
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Pokemon(context.Background(), "pikachu")
+			errs <- err
+		}()
+	}
+	// Hold the response until every caller has missed the cache and joined the flight
+	for client.Stats().Requests < 1 || cache.Stats().Misses < callers {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if requests.Load() != 1 {
+		t.Errorf("expected 1 request; Got: %v", requests.Load())
+	}
+	if client.Stats().Coalesced != callers-1 {
+		t.Errorf("expected %v coalesced fetches; Got: %v", callers-1, client.Stats().Coalesced)
+	}
+}
+
+func TestFetchMany(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		name := strings.TrimPrefix(r.URL.Path, "/pokemon/")
+		if name == "missingno" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, `{"name": %q}`, name)
+	}))
+	defer server.Close()
+	client := NewClient(pokecache.NewCache(), WithBaseURL(server.URL), WithRetryPolicy(RetryPolicy{MaxAttempts: 1}), WithRateLimit(0, 0))
+
+	names := []string{"bulbasaur", "ivysaur", "venusaur", "missingno", "charmander", "charmeleon", "charizard", "squirtle"}
+	results := client.FetchMany(context.Background(), names, 3)
+	if len(results) != len(names) {
+		t.Fatalf("expected %v results; Got: %v", len(names), len(results))
+	}
+	for i, result := range results {
+		if result.Name != names[i] {
+			t.Errorf("result %v: expected %v; Got: %v", i, names[i], result.Name)
+		}
+		if names[i] == "missingno" {
+			if !errors.Is(result.Err, ErrNotFound) {
+				t.Errorf("expected ErrNotFound for missingno; Got: %v", result.Err)
+			}
+			continue
+		}
+		if result.Err != nil || result.Value.Name != names[i] {
+			t.Errorf("result %v: expected %v; Got: %+v", i, names[i], result)
+		}
+	}
+	if maxInFlight.Load() > 3 {
+		t.Errorf("expected at most 3 requests in flight; Got: %v", maxInFlight.Load())
+	}
+
+	// Everything but missingno is cached by now
+	requests := client.Stats().Requests
+	client.FetchMany(context.Background(), []string{"bulbasaur", "charizard"}, 3)
+	if client.Stats().Requests != requests {
+		t.Errorf("expected cached Pokemon not to be requested again")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, result := range client.FetchMany(ctx, []string{"wartortle", "blastoise"}, 3) {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("expected a cancelled fetch; Got: %v", result.Err)
+		}
+	}
+}
+
+func TestClientRevalidation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"name": "pikachu"}`)
+	}))
+	defer server.Close()
+
+	clock := &testClock{now: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	cache := pokecache.NewCache(
+		pokecache.WithInterval(time.Minute),
+		pokecache.WithStaleWhileRevalidate(time.Minute),
+		pokecache.WithClock(clock),
+	)
+	defer cache.Close()
+	client := NewClient(cache, WithBaseURL(server.URL))
+	url := client.ResourceURL("pokemon", "pikachu")
+
+	fetch := func() {
+		t.Helper()
+		pokemon, err := client.Pokemon(context.Background(), "pikachu")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if pokemon.Name != "pikachu" {
+			t.Errorf("unexpected pokemon: %+v", pokemon)
+		}
+	}
+
+	fetch()
+	// Stale: served from the cache straight away and refreshed in the background
+	clock.Advance(90 * time.Second)
+	fetch()
+	for client.Stats().Revalidated < 1 {
+		time.Sleep(time.Millisecond)
+	}
+	if _, freshness, _ := cache.Lookup(url); freshness != pokecache.Fresh {
+		t.Errorf("expected a 304 to make the entry fresh again; Got: %v", freshness)
+	}
+
+	// Expired: revalidated before answering
+	clock.Advance(10 * time.Minute)
+	fetch()
+	stats := client.Stats()
+	if stats.Requests != 3 || stats.Revalidated != 2 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
+
+// testClock only moves when told to; its tickers never fire
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func (c *testClock) NewTicker(d time.Duration) pokecache.Ticker {
+	return testTicker{}
+}
+
+type testTicker struct{}
+
+func (testTicker) C() <-chan time.Time {
+	return nil
+}
+
+func (testTicker) Stop() {}
+
+func TestMirror(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pokemon":
+			// Two pages, to make sure the Next links are followed
+			if r.URL.Query().Get("offset") == "" {
+				fmt.Fprintf(w, `{"count": 3, "next": "%v/pokemon?offset=2&limit=2", "results": [
+					{"name": "bulbasaur", "url": "%v/pokemon/1/"}, {"name": "ivysaur", "url": "%v/pokemon/2/"}]}`,
+					server.URL, server.URL, server.URL)
+				return
+			}
+			fmt.Fprintf(w, `{"count": 3, "next": null, "results": [{"name": "venusaur", "url": "%v/pokemon/3/"}]}`, server.URL)
+		case "/pokemon/1/", "/pokemon/2/", "/pokemon/3/":
+			id := strings.Trim(strings.TrimPrefix(r.URL.Path, "/pokemon/"), "/")
+			fmt.Fprintf(w, `{"id": %v, "name": "pokemon-%v"}`, id, id)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	online := NewClient(pokecache.NewCache(), WithBaseURL(server.URL))
+	saved := 0
+	err := online.Mirror(context.Background(), dir, []string{"pokemon"}, func(resource string, done, total int) {
+		saved = done
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if saved != 3 {
+		t.Errorf("expected 3 resources to be saved; Got: %v", saved)
+	}
+
+	offline := NewClient(pokecache.NewCache(), WithOffline(dir))
+	pokemon, err := offline.Pokemon(context.Background(), "ivysaur")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pokemon.Id != 2 {
+		t.Errorf("unexpected pokemon: %+v", pokemon)
+	}
+	_, err = offline.Pokemon(context.Background(), "mew")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound; Got: %v", err)
+	}
+	page, err := Get[NamedAPIResourceList[Pokemon]](context.Background(), offline, offline.ResourceURL("pokemon")+"?limit=2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if page.Count != 3 || len(page.Results) != 2 || page.Next == "" || page.Previous != "" {
+		t.Errorf("unexpected page: %+v", page)
+	}
+}
+
+// newListServer serves a list endpoint of count berries with offset/limit paging
+func newListServer(count int) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, limit := 0, DefaultPageSize
+		fmt.Sscan(r.URL.Query().Get("offset"), &offset)
+		fmt.Sscan(r.URL.Query().Get("limit"), &limit)
+		page := NamedAPIResourceList[Berry]{Count: count, Results: []NamedAPIResource[Berry]{}}
+		for i := offset; i < min(offset+limit, count); i++ {
+			page.Results = append(page.Results, NamedAPIResource[Berry]{Name: fmt.Sprintf("berry-%d", i+1)})
+		}
+		if offset+limit < count {
+			page.Next = fmt.Sprintf("%v/berry?offset=%d&limit=%d", server.URL, offset+limit, limit)
+		}
+		if offset > 0 {
+			page.Previous = fmt.Sprintf("%v/berry?offset=%d&limit=%d", server.URL, max(offset-limit, 0), limit)
+		}
+		json.NewEncoder(w).Encode(page)
+	}))
+	return server
+}
+
+func TestPaginator(t *testing.T) {
+	server := newListServer(45)
+	defer server.Close()
+	client := NewClient(pokecache.NewCache(), WithBaseURL(server.URL))
+	ctx := context.Background()
+	pages := NewPaginator[Berry](client, "berry", 0)
+
+	if _, err := pages.Prev(ctx); !errors.Is(err, ErrNoMorePages) {
+		t.Errorf("expected ErrNoMorePages before the first page; Got: %v", err)
+	}
+	expectPage := func(page NamedAPIResourceList[Berry], err error, number int, first string) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if pages.Number() != number || page.Results[0].Name != first {
+			t.Errorf("expected page %v starting with %v; Got page %v starting with %v", number, first, pages.Number(), page.Results[0].Name)
+		}
+	}
+	page, err := pages.Next(ctx)
+	expectPage(page, err, 0, "berry-1")
+	page, err = pages.Next(ctx)
+	expectPage(page, err, 1, "berry-21")
+	page, err = pages.Next(ctx)
+	expectPage(page, err, 2, "berry-41")
+	if _, err := pages.Next(ctx); !errors.Is(err, ErrNoMorePages) {
+		t.Errorf("expected ErrNoMorePages after the last page; Got: %v", err)
+	}
+	page, err = pages.Prev(ctx)
+	expectPage(page, err, 1, "berry-21")
+	page, err = pages.Page(ctx, 0)
+	expectPage(page, err, 0, "berry-1")
+	if _, err := pages.Page(ctx, 3); !errors.Is(err, ErrNoMorePages) {
+		t.Errorf("expected ErrNoMorePages past the last page; Got: %v", err)
+	}
+	if pages.Number() != 0 {
+		t.Errorf("expected a failed move to stay on page 0; Got: %v", pages.Number())
+	}
 
+	var names []string
+	for berry, err := range NewPaginator[Berry](client, "berry", 10).All(ctx) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		names = append(names, berry.Name)
+	}
+	if len(names) != 45 || names[0] != "berry-1" || names[44] != "berry-45" {
+		t.Errorf("expected all 45 berries in order; Got: %v", names)
+	}
 }
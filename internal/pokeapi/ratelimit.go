@@ -0,0 +1,52 @@
+package pokeapi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket: it holds up to burst tokens, refilled at perSecond,
+// and every request spends one
+type rateLimiter struct {
+	mu        sync.Mutex
+	perSecond float64
+	burst     float64
+	tokens    float64
+	last      time.Time
+}
+
+func newRateLimiter(perSecond float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		perSecond: perSecond,
+		burst:     float64(burst),
+		tokens:    float64(burst),
+		last:      time.Now(),
+	}
+}
+
+// wait blocks until a token is available, reporting whether it had to wait at all
+func (l *rateLimiter) wait(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.perSecond)
+	l.last = now
+	// Take the token now; going negative reserves one from the future
+	l.tokens--
+	if l.tokens >= 0 {
+		l.mu.Unlock()
+		return false, nil
+	}
+	wait := time.Duration(-l.tokens / l.perSecond * float64(time.Second))
+	l.mu.Unlock()
+
+	err := sleep(ctx, wait)
+	if err != nil {
+		// Hand the reserved token back, we never used it
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return true, err
+	}
+	return true, nil
+}
@@ -0,0 +1,52 @@
+package pokeapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// NamedAPIResource is a {name, url} reference to a T, as embedded in other resources
+type NamedAPIResource[T any] struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// Resolve fetches the full resource the reference points to
+func (r NamedAPIResource[T]) Resolve(ctx context.Context, c *Client) (T, error) {
+	return resolve[T](ctx, c, r.URL)
+}
+
+// APIResource is a {url} reference to a T, used for resources without names
+type APIResource[T any] struct {
+	URL string `json:"url"`
+}
+
+// Resolve fetches the full resource the reference points to
+func (r APIResource[T]) Resolve(ctx context.Context, c *Client) (T, error) {
+	return resolve[T](ctx, c, r.URL)
+}
+
+func resolve[T any](ctx context.Context, c *Client, url string) (T, error) {
+	if url == "" {
+		// The API uses null for missing references, which decodes to an empty one
+		var empty T
+		return empty, fmt.Errorf("%w: empty reference", ErrNotFound)
+	}
+	return Get[T](ctx, c, url)
+}
+
+// Get fetches the resource at url and decodes it into a T.
+// Responses are cached by url, so resolving the same reference twice only hits the network once.
+func Get[T any](ctx context.Context, c *Client, url string) (T, error) {
+	var result T
+	body, err := c.fetch(ctx, url)
+	if err != nil {
+		return result, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return result, &DecodeError{URL: url, Err: err}
+	}
+	return result, nil
+}
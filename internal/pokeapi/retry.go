@@ -0,0 +1,112 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy decides how often and how long to wait between attempts at a failed request
+type RetryPolicy struct {
+	// MaxAttempts counts the first try, so 1 disables retrying
+	MaxAttempts int
+	// BaseDelay is the backoff before the first retry, doubled for each retry after that
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff, including waits asked for through Retry-After
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy is used unless WithRetryPolicy says otherwise
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   250 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+}
+
+// backoff returns a random delay in [0, BaseDelay*2^retry], capped by MaxDelay ("full jitter")
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	ceiling := p.BaseDelay << retry
+	if ceiling <= 0 || ceiling > p.MaxDelay {
+		ceiling = p.MaxDelay
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(ceiling) + 1))
+}
+
+// delay returns how long to wait before the next attempt, preferring the server's Retry-After
+func (p RetryPolicy) delay(retry int, err error) time.Duration {
+	wait := p.backoff(retry)
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) {
+		if retryAfter, ok := parseRetryAfter(statusErr.Header.Get("Retry-After"), time.Now()); ok {
+			wait = retryAfter
+		}
+	}
+	if p.MaxDelay > 0 && wait > p.MaxDelay {
+		wait = p.MaxDelay
+	}
+	return wait
+}
+
+// retryable reports whether a failed attempt is worth trying again
+func retryable(ctx context.Context, err error) bool {
+	// The caller gave up, retrying would not help
+	if ctx.Err() != nil {
+		return false
+	}
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return retryableStatus(statusErr.StatusCode)
+	}
+	// Timeouts and dropped connections on the way to the server
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// retryableStatus reports whether a response with this status is worth trying again
+func retryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter understands both forms of the header: a number of seconds or an HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
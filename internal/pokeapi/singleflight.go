@@ -0,0 +1,48 @@
+package pokeapi
+
+import (
+	"context"
+	"sync"
+)
+
+// flightGroup makes concurrent fetches of the same key share one call
+type flightGroup struct {
+	mu     sync.Mutex
+	flying map[string]*flight
+}
+
+// flight is a call in progress; body and err are set before done is closed
+type flight struct {
+	done chan struct{}
+	body []byte
+	err  error
+}
+
+// do runs fn for key unless a call for key is already in flight, in which case it waits
+// for that call's result instead. shared reports whether the result came from another caller.
+func (g *flightGroup) do(ctx context.Context, key string, fn func() ([]byte, error)) (body []byte, err error, shared bool) {
+	g.mu.Lock()
+	if g.flying == nil {
+		g.flying = make(map[string]*flight)
+	}
+	if call, ok := g.flying[key]; ok {
+		g.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.body, call.err, true
+		case <-ctx.Done():
+			return nil, ctx.Err(), true
+		}
+	}
+	call := &flight{done: make(chan struct{})}
+	g.flying[key] = call
+	g.mu.Unlock()
+
+	call.body, call.err = fn()
+
+	g.mu.Lock()
+	delete(g.flying, key)
+	g.mu.Unlock()
+	close(call.done)
+	return call.body, call.err, false
+}
@@ -0,0 +1,49 @@
+package pokeapi
+
+import (
+	"context"
+	"strings"
+)
+
+// DefaultLanguage is the language used when a text is not available in the one asked for
+const DefaultLanguage = "en"
+
+// PokemonSpecies gets a single Pokemon species by name or id.
+// A Pokemon's own species is also reachable with pokemon.Species.Resolve.
+func (c *Client) PokemonSpecies(ctx context.Context, name string) (PokemonSpecies, error) {
+	return Get[PokemonSpecies](ctx, c, c.ResourceURL("pokemon-species", name))
+}
+
+// Genus returns the species' genus (e.g. "Mouse Pokémon") in language,
+// falling back to DefaultLanguage, or an empty string if neither is available
+func (s PokemonSpecies) Genus(language string) string {
+	genus, _ := localized(s.Genera, language, func(genus Genus) (string, string) {
+		return genus.Language.Name, genus.Genus
+	})
+	return genus
+}
+
+// FlavorText returns the species' most recent Pokedex entry in language, falling back to
+// DefaultLanguage, or an empty string if neither is available. The line and page breaks
+// the games needed are replaced with spaces.
+func (s PokemonSpecies) FlavorText(language string) string {
+	text, _ := localized(s.FlavorTextEntries, language, func(entry FlavorText) (string, string) {
+		return entry.Language.Name, entry.FlavorText
+	})
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// localized finds the text of the entry in language, falling back to DefaultLanguage.
+// pick returns an entry's language and text. Entries are listed from the oldest game to
+// the newest, so the newest match wins.
+func localized[T any](entries []T, language string, pick func(T) (string, string)) (string, bool) {
+	for _, candidate := range []string{language, DefaultLanguage} {
+		for i := len(entries) - 1; i >= 0; i-- {
+			entryLanguage, text := pick(entries[i])
+			if entryLanguage == candidate {
+				return text, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,472 @@
+package pokeapitest
+
+import (
+	"fmt"
+	"slices"
+
+	pokeapi "github.com/avgra3/pokedexcli/internal/pokeapi"
+)
+
+// Dataset is what a Server serves. References inside it should use pokeapi.DefaultBaseURL,
+// the server rewrites them to point at itself.
+type Dataset struct {
+	Pokemon         []pokeapi.Pokemon
+	Species         []pokeapi.PokemonSpecies
+	LocationAreas   []pokeapi.LocationArea
+	EvolutionChains []pokeapi.EvolutionChain
+	Types           []pokeapi.Type
+	Moves           []pokeapi.Move
+	Items           []pokeapi.Item
+}
+
+// Ref builds a reference to a resource the way the PokeAPI does
+func Ref[T any](resource string, id int, name string) pokeapi.NamedAPIResource[T] {
+	return pokeapi.NamedAPIResource[T]{Name: name, URL: fmt.Sprintf("%v/%v/%d/", pokeapi.DefaultBaseURL, resource, id)}
+}
+
+// typeNames are the PokeAPI's types in id order, from normal (1) to fairy (18)
+var typeNames = []string{
+	"normal", "fighting", "flying", "poison", "ground", "rock", "bug", "ghost", "steel",
+	"fire", "water", "grass", "electric", "psychic", "ice", "dragon", "dark", "fairy",
+}
+
+// typeIDs are the PokeAPI ids of the types
+var typeIDs = func() map[string]int {
+	ids := map[string]int{}
+	for i, name := range typeNames {
+		ids[name] = i + 1
+	}
+	return ids
+}()
+
+// typeChart is how each attacking type does against the others, as of generation VI
+var typeChart = map[string]struct{ double, half, none []string }{
+	"normal":   {half: []string{"rock", "steel"}, none: []string{"ghost"}},
+	"fighting": {double: []string{"normal", "rock", "steel", "ice", "dark"}, half: []string{"flying", "poison", "bug", "psychic", "fairy"}, none: []string{"ghost"}},
+	"flying":   {double: []string{"fighting", "bug", "grass"}, half: []string{"rock", "steel", "electric"}},
+	"poison":   {double: []string{"grass", "fairy"}, half: []string{"poison", "ground", "rock", "ghost"}, none: []string{"steel"}},
+	"ground":   {double: []string{"poison", "rock", "steel", "fire", "electric"}, half: []string{"bug", "grass"}, none: []string{"flying"}},
+	"rock":     {double: []string{"flying", "bug", "fire", "ice"}, half: []string{"fighting", "ground", "steel"}},
+	"bug":      {double: []string{"grass", "psychic", "dark"}, half: []string{"fighting", "flying", "poison", "ghost", "steel", "fire", "fairy"}},
+	"ghost":    {double: []string{"ghost", "psychic"}, half: []string{"dark"}, none: []string{"normal"}},
+	"steel":    {double: []string{"rock", "ice", "fairy"}, half: []string{"steel", "fire", "water", "electric"}},
+	"fire":     {double: []string{"bug", "steel", "grass", "ice"}, half: []string{"rock", "fire", "water", "dragon"}},
+	"water":    {double: []string{"ground", "rock", "fire"}, half: []string{"water", "grass", "dragon"}},
+	"grass":    {double: []string{"ground", "rock", "water"}, half: []string{"flying", "poison", "bug", "steel", "fire", "grass", "dragon"}},
+	"electric": {double: []string{"flying", "water"}, half: []string{"grass", "electric", "dragon"}, none: []string{"ground"}},
+	"psychic":  {double: []string{"fighting", "poison"}, half: []string{"steel", "psychic"}, none: []string{"dark"}},
+	"ice":      {double: []string{"flying", "ground", "grass", "dragon"}, half: []string{"steel", "fire", "water", "ice"}},
+	"dragon":   {double: []string{"dragon"}, half: []string{"steel"}, none: []string{"fairy"}},
+	"dark":     {double: []string{"ghost", "psychic"}, half: []string{"fighting", "dark", "fairy"}},
+	"fairy":    {double: []string{"fighting", "dragon", "dark"}, half: []string{"poison", "steel", "fire"}},
+}
+
+// typeGenerations are the generations types were introduced in, where it isn't the first
+var typeGenerations = map[string]int{"dark": 2, "steel": 2, "fairy": 6}
+
+// pastTypeChart is how attacking types did in earlier generations, each entry applying up to
+// and including its generation
+var pastTypeChart = map[string][]struct {
+	generation         int
+	double, half, none []string
+}{
+	"ghost": {
+		{generation: 1, double: []string{"ghost"}, none: []string{"normal", "psychic"}},
+		{generation: 5, double: []string{"ghost", "psychic"}, half: []string{"dark", "steel"}, none: []string{"normal"}},
+	},
+	"dark": {
+		{generation: 5, double: []string{"ghost", "psychic"}, half: []string{"fighting", "dark", "steel"}},
+	},
+}
+
+// GenerationRef refers to generation n by its PokeAPI name, e.g. "generation-iii"
+func GenerationRef(n int) pokeapi.NamedAPIResource[pokeapi.Generation] {
+	numerals := []string{"i", "ii", "iii", "iv", "v", "vi", "vii", "viii", "ix"}
+	return Ref[pokeapi.Generation]("generation", n, "generation-"+numerals[n-1])
+}
+
+// NewTypes builds all 18 types with their damage relations, plus the relation-less "unknown" type
+func NewTypes() []pokeapi.Type {
+	typeRefs := func(names []string) []pokeapi.NamedAPIResource[pokeapi.Type] {
+		refs := []pokeapi.NamedAPIResource[pokeapi.Type]{}
+		for _, name := range names {
+			refs = append(refs, Ref[pokeapi.Type]("type", typeIDs[name], name))
+		}
+		return refs
+	}
+	types := []pokeapi.Type{}
+	for _, name := range typeNames {
+		relations := pokeapi.TypeRelations{
+			DoubleDamageTo: typeRefs(typeChart[name].double),
+			HalfDamageTo:   typeRefs(typeChart[name].half),
+			NoDamageTo:     typeRefs(typeChart[name].none),
+		}
+		for _, attacker := range typeNames {
+			against := typeChart[attacker]
+			if slices.Contains(against.double, name) {
+				relations.DoubleDamageFrom = append(relations.DoubleDamageFrom, Ref[pokeapi.Type]("type", typeIDs[attacker], attacker))
+			}
+			if slices.Contains(against.half, name) {
+				relations.HalfDamageFrom = append(relations.HalfDamageFrom, Ref[pokeapi.Type]("type", typeIDs[attacker], attacker))
+			}
+			if slices.Contains(against.none, name) {
+				relations.NoDamageFrom = append(relations.NoDamageFrom, Ref[pokeapi.Type]("type", typeIDs[attacker], attacker))
+			}
+		}
+		pokemonType := pokeapi.Type{
+			Id:              typeIDs[name],
+			Name:            name,
+			DamageRelations: relations,
+			Generation:      GenerationRef(max(typeGenerations[name], 1)),
+		}
+		// Only the attacking side of past relations, which is all a type chart needs
+		for _, past := range pastTypeChart[name] {
+			pokemonType.PastDamageRelations = append(pokemonType.PastDamageRelations, pokeapi.TypeRelationsPast{
+				Generation: GenerationRef(past.generation),
+				DamageRelations: pokeapi.TypeRelations{
+					DoubleDamageTo: typeRefs(past.double),
+					HalfDamageTo:   typeRefs(past.half),
+					NoDamageTo:     typeRefs(past.none),
+				},
+			})
+		}
+		types = append(types, pokemonType)
+	}
+	return append(types, pokeapi.Type{Id: 10001, Name: "unknown"})
+}
+
+// habitatIDs are the PokeAPI ids of the Pokemon habitats
+var habitatIDs = map[string]int{
+	"cave": 1, "forest": 2, "grassland": 3, "mountain": 4, "rare": 5,
+	"rough-terrain": 6, "sea": 7, "urban": 8, "waters-edge": 9,
+}
+
+// statNames are the base stats in the order the PokeAPI lists them
+var statNames = []string{"hp", "attack", "defense", "special-attack", "special-defense", "speed"}
+
+// NewPokemon builds a Pokemon with its species, types and base stats (hp, attack, defense,
+// special-attack, special-defense, speed) filled in
+func NewPokemon(id int, name string, baseExperience int, types []string, stats [6]int) pokeapi.Pokemon {
+	pokemon := pokeapi.Pokemon{
+		Id:             id,
+		Name:           name,
+		BaseExperience: baseExperience,
+		Height:         id%20 + 3,
+		Weight:         id*10 + 20,
+		IsDefault:      true,
+		Order:          id,
+		Species:        Ref[pokeapi.PokemonSpecies]("pokemon-species", id, name),
+	}
+	for i, typeName := range types {
+		pokemon.Types = append(pokemon.Types, pokeapi.PokemonType{
+			Slot: i + 1,
+			Type: Ref[pokeapi.Type]("type", typeIDs[typeName], typeName),
+		})
+	}
+	for i, baseStat := range stats {
+		pokemon.Stats = append(pokemon.Stats, pokeapi.PokemonStat{
+			Stat:     Ref[pokeapi.Stat]("stat", i+1, statNames[i]),
+			BaseStat: baseStat,
+		})
+	}
+	return pokemon
+}
+
+// NewSpecies builds a species with an English genus and flavor text
+func NewSpecies(id int, name string, captureRate int, genus, flavorText, habitat string) pokeapi.PokemonSpecies {
+	english := Ref[pokeapi.Language]("language", 9, "en")
+	species := pokeapi.PokemonSpecies{
+		Id:          id,
+		Name:        name,
+		Order:       id,
+		CaptureRate: captureRate,
+		Genera:      []pokeapi.Genus{{Genus: genus, Language: english}},
+		FlavorTextEntries: []pokeapi.FlavorText{
+			{FlavorText: flavorText, Language: english},
+		},
+		Generation: Ref[pokeapi.Generation]("generation", 1, "generation-i"),
+		Varieties: []pokeapi.PokemonSpeciesVariety{
+			{IsDefault: true, Pokemon: Ref[pokeapi.Pokemon]("pokemon", id, name)},
+		},
+	}
+	if habitat != "" {
+		species.Habitat = Ref[pokeapi.PokemonHabitat]("pokemon-habitat", habitatIDs[habitat], habitat)
+	}
+	return species
+}
+
+// Evolution builds a link of an evolution chain: species id, evolving under details, into evolvesTo
+func Evolution(id int, name string, details []pokeapi.EvolutionDetail, evolvesTo ...pokeapi.ChainLink) pokeapi.ChainLink {
+	return pokeapi.ChainLink{
+		Species:          Ref[pokeapi.PokemonSpecies]("pokemon-species", id, name),
+		EvolutionDetails: details,
+		EvolvesTo:        evolvesTo,
+	}
+}
+
+// NewEvolutionChain builds an evolution chain and points the species in it at the chain
+func NewEvolutionChain(id int, chain pokeapi.ChainLink, species []pokeapi.PokemonSpecies) pokeapi.EvolutionChain {
+	inChain := map[string]bool{}
+	var walk func(link pokeapi.ChainLink)
+	walk = func(link pokeapi.ChainLink) {
+		inChain[link.Species.Name] = true
+		for _, next := range link.EvolvesTo {
+			walk(next)
+		}
+	}
+	walk(chain)
+	for i := range species {
+		if inChain[species[i].Name] {
+			species[i].EvolutionChain = pokeapi.APIResource[pokeapi.EvolutionChain]{
+				URL: fmt.Sprintf("%v/evolution-chain/%d/", pokeapi.DefaultBaseURL, id),
+			}
+		}
+	}
+	return pokeapi.EvolutionChain{Id: id, Chain: &chain}
+}
+
+// levelUp and useItem are the two most common ways to evolve
+func levelUp(minLevel int) pokeapi.EvolutionDetail {
+	return pokeapi.EvolutionDetail{Trigger: Ref[pokeapi.EvolutionTrigger]("evolution-trigger", 1, "level-up"), MinLevel: minLevel}
+}
+
+func useItem(itemID int, item string) pokeapi.EvolutionDetail {
+	return pokeapi.EvolutionDetail{
+		Trigger: Ref[pokeapi.EvolutionTrigger]("evolution-trigger", 3, "use-item"),
+		Item:    Ref[pokeapi.Item]("item", itemID, item),
+	}
+}
+
+// NewMove builds a move; damageClass is "physical", "special" or "status"
+func NewMove(id int, name, typeName, damageClass string, power, accuracy, pp, priority int) pokeapi.Move {
+	damageClassIDs := map[string]int{"status": 1, "physical": 2, "special": 3}
+	return pokeapi.Move{
+		Id:          id,
+		Name:        name,
+		Type:        Ref[pokeapi.Type]("type", typeIDs[typeName], typeName),
+		DamageClass: Ref[pokeapi.MoveDamageClass]("move-damage-class", damageClassIDs[damageClass], damageClass),
+		Power:       power,
+		Accuracy:    accuracy,
+		Pp:          pp,
+		Priority:    priority,
+	}
+}
+
+// LearnMove teaches pokemon move by leveling up to level
+func LearnMove(pokemon *pokeapi.Pokemon, move pokeapi.Move, level int) {
+	pokemon.Moves = append(pokemon.Moves, pokeapi.PokemonMove{
+		Move: Ref[pokeapi.Move]("move", move.Id, move.Name),
+		VersionGroupDetails: []pokeapi.PokemonMoveVersion{{
+			MoveLearnMethod: Ref[pokeapi.MoveLearnMethod]("move-learn-method", 1, "level-up"),
+			VersionGroup:    Ref[pokeapi.VersionGroup]("version-group", 25, "scarlet-violet"),
+			LevelLearnedAt:  level,
+		}},
+	})
+}
+
+// NewItem builds an item with an English name and description
+func NewItem(id int, name string, cost int, category, englishName, flavorText string) pokeapi.Item {
+	english := Ref[pokeapi.Language]("language", 9, "en")
+	return pokeapi.Item{
+		Id:       id,
+		Name:     name,
+		Cost:     cost,
+		Category: Ref[pokeapi.ItemCategory]("item-category", itemCategoryIDs[category], category),
+		Names:    []pokeapi.Name{{Name: englishName, Language: english}},
+		FlavorTextEntries: []pokeapi.VersionGroupFlavorText{{
+			Text:         flavorText,
+			Language:     english,
+			VersionGroup: Ref[pokeapi.VersionGroup]("version-group", 25, "scarlet-violet"),
+		}},
+	}
+}
+
+// itemCategoryIDs are the ids of the item categories NewItem knows about
+var itemCategoryIDs = map[string]int{"special-balls": 33, "standard-balls": 34}
+
+// NewLocationArea builds a location area where the given Pokemon can be encountered
+func NewLocationArea(id int, name string, pokemon ...pokeapi.Pokemon) pokeapi.LocationArea {
+	locationArea := pokeapi.LocationArea{Id: id, Name: name, GameIndex: id}
+	for _, encountered := range pokemon {
+		locationArea.PokemonEncounters = append(locationArea.PokemonEncounters, pokeapi.PokemonEncounter{
+			Pokemon: Ref[pokeapi.Pokemon]("pokemon", encountered.Id, encountered.Name),
+		})
+	}
+	return locationArea
+}
+
+// DefaultDataset is a small slice of the real PokeAPI: a handful of Pokemon with their species,
+// and enough location areas for several pages of the map command
+func DefaultDataset() Dataset {
+	bulbasaur := NewPokemon(1, "bulbasaur", 64, []string{"grass", "poison"}, [6]int{45, 49, 49, 65, 65, 45})
+	charmander := NewPokemon(4, "charmander", 62, []string{"fire"}, [6]int{39, 52, 43, 60, 50, 65})
+	squirtle := NewPokemon(7, "squirtle", 63, []string{"water"}, [6]int{44, 48, 65, 50, 64, 43})
+	pidgey := NewPokemon(16, "pidgey", 50, []string{"normal", "flying"}, [6]int{40, 45, 40, 35, 35, 56})
+	pikachu := NewPokemon(25, "pikachu", 112, []string{"electric"}, [6]int{35, 55, 40, 50, 50, 90})
+	zubat := NewPokemon(41, "zubat", 49, []string{"poison", "flying"}, [6]int{40, 45, 35, 30, 40, 55})
+	tentacool := NewPokemon(72, "tentacool", 67, []string{"water", "poison"}, [6]int{40, 40, 35, 50, 100, 70})
+	geodude := NewPokemon(74, "geodude", 60, []string{"rock", "ground"}, [6]int{40, 80, 100, 30, 30, 20})
+	magikarp := NewPokemon(129, "magikarp", 40, []string{"water"}, [6]int{20, 10, 55, 15, 20, 80})
+	eevee := NewPokemon(133, "eevee", 65, []string{"normal"}, [6]int{55, 55, 50, 45, 65, 55})
+	clefairy := NewPokemon(35, "clefairy", 113, []string{"fairy"}, [6]int{70, 45, 48, 60, 65, 35})
+	// Clefairy was a Normal type until the Fairy type came along
+	clefairy.PastTypes = []pokeapi.PokemonTypePast{{
+		Generation: GenerationRef(5),
+		Types:      []pokeapi.PokemonType{{Slot: 1, Type: Ref[pokeapi.Type]("type", typeIDs["normal"], "normal")}},
+	}}
+
+	tackle := NewMove(33, "tackle", "normal", "physical", 40, 100, 35, 0)
+	scratch := NewMove(10, "scratch", "normal", "physical", 40, 100, 35, 0)
+	quickAttack := NewMove(98, "quick-attack", "normal", "physical", 40, 100, 30, 1)
+	growl := NewMove(45, "growl", "normal", "status", 0, 100, 40, 0)
+	splash := NewMove(150, "splash", "normal", "status", 0, 0, 40, 0)
+	thunderShock := NewMove(84, "thunder-shock", "electric", "special", 40, 100, 30, 0)
+	thunderbolt := NewMove(85, "thunderbolt", "electric", "special", 90, 100, 15, 0)
+	ember := NewMove(52, "ember", "fire", "special", 40, 100, 25, 0)
+	waterGun := NewMove(55, "water-gun", "water", "special", 40, 100, 25, 0)
+	vineWhip := NewMove(22, "vine-whip", "grass", "physical", 45, 100, 25, 0)
+	rockThrow := NewMove(88, "rock-throw", "rock", "physical", 50, 90, 15, 0)
+	bulldoze := NewMove(523, "bulldoze", "ground", "physical", 60, 100, 20, 0)
+	gust := NewMove(16, "gust", "flying", "special", 40, 100, 35, 0)
+	for _, learns := range []struct {
+		pokemon *pokeapi.Pokemon
+		move    pokeapi.Move
+		level   int
+	}{
+		{&bulbasaur, tackle, 1}, {&bulbasaur, growl, 1}, {&bulbasaur, vineWhip, 3},
+		{&charmander, scratch, 1}, {&charmander, growl, 1}, {&charmander, ember, 4},
+		{&squirtle, tackle, 1}, {&squirtle, waterGun, 3},
+		{&pidgey, tackle, 1}, {&pidgey, gust, 9}, {&pidgey, quickAttack, 13},
+		{&pikachu, thunderShock, 1}, {&pikachu, growl, 1}, {&pikachu, quickAttack, 1}, {&pikachu, thunderbolt, 36},
+		{&geodude, tackle, 1}, {&geodude, rockThrow, 10}, {&geodude, bulldoze, 16},
+		{&magikarp, splash, 1}, {&magikarp, tackle, 15},
+		{&eevee, tackle, 1}, {&eevee, quickAttack, 10},
+	} {
+		LearnMove(learns.pokemon, learns.move, learns.level)
+	}
+
+	dataset := Dataset{
+		Moves:   []pokeapi.Move{tackle, scratch, quickAttack, growl, splash, thunderShock, thunderbolt, ember, waterGun, vineWhip, rockThrow, bulldoze, gust},
+		Pokemon: []pokeapi.Pokemon{bulbasaur, charmander, squirtle, pidgey, pikachu, zubat, tentacool, geodude, magikarp, eevee, clefairy},
+		Types:   NewTypes(),
+		Items: []pokeapi.Item{
+			NewItem(1, "master-ball", 0, "standard-balls", "Master Ball", "The best Poké Ball with the ultimate level of performance. With it, you will catch any wild Pokémon without fail."),
+			NewItem(2, "ultra-ball", 800, "standard-balls", "Ultra Ball", "An ultra-high-performance Poké Ball that provides a higher success rate for catching Pokémon than a Great Ball."),
+			NewItem(3, "great-ball", 600, "standard-balls", "Great Ball", "A good, high-performance Poké Ball that provides a higher Pokémon catch rate than a standard Poké Ball."),
+			NewItem(4, "poke-ball", 200, "standard-balls", "Poké Ball", "A device for catching wild Pokémon. It's thrown like a ball at a Pokémon, comfortably encapsulating its target."),
+			NewItem(6, "net-ball", 1000, "special-balls", "Net Ball", "A somewhat different Poké Ball that is more effective when attempting to catch Water- or Bug-type Pokémon."),
+			NewItem(8, "repeat-ball", 1000, "special-balls", "Repeat Ball", "A somewhat different Poké Ball that works especially well on a Pokémon species that has been caught before."),
+			NewItem(13, "dusk-ball", 1000, "special-balls", "Dusk Ball", "A somewhat different Poké Ball that makes it easier to catch wild Pokémon at night or in dark places like caves."),
+			NewItem(15, "quick-ball", 1000, "special-balls", "Quick Ball", "A somewhat different Poké Ball that has a more successful catch rate if used at the start of a wild encounter."),
+		},
+		Species: []pokeapi.PokemonSpecies{
+			NewSpecies(1, "bulbasaur", 45, "Seed Pokémon", "A strange seed was planted on its back at birth.", "grassland"),
+			NewSpecies(4, "charmander", 45, "Lizard Pokémon", "The flame on its tail shows the strength of its life force.", "mountain"),
+			NewSpecies(7, "squirtle", 45, "Tiny Turtle Pokémon", "It shelters itself in its shell and then strikes back.", "waters-edge"),
+			NewSpecies(16, "pidgey", 255, "Tiny Bird Pokémon", "A common sight in forests and woods.", "forest"),
+			NewSpecies(25, "pikachu", 190, "Mouse Pokémon", "When several of these Pokémon gather, their electricity could build and cause lightning storms.", "forest"),
+			NewSpecies(35, "clefairy", 150, "Fairy Pokémon", "Its magical and cute appeal has many admirers. It is rare and found only in certain areas.", "mountain"),
+			NewSpecies(41, "zubat", 255, "Bat Pokémon", "It emits ultrasonic waves from its mouth to check its surroundings.", "cave"),
+			NewSpecies(72, "tentacool", 190, "Jellyfish Pokémon", "Drifts in shallow seas.", "sea"),
+			NewSpecies(74, "geodude", 255, "Rock Pokémon", "Found in fields and mountains.", "mountain"),
+			NewSpecies(129, "magikarp", 255, "Fish Pokémon", "In the distant past, it was somewhat stronger than the horribly weak descendants that exist today.", "waters-edge"),
+			NewSpecies(133, "eevee", 45, "Evolution Pokémon", "Its genetic code is irregular. It may mutate if it is exposed to radiation from element stones.", "urban"),
+		},
+	}
+
+	friendship := func(timeOfDay string) pokeapi.EvolutionDetail {
+		detail := levelUp(0)
+		detail.MinHappiness = 160
+		detail.TimeOfDay = timeOfDay
+		return detail
+	}
+	atLocation := func(locationID int, location string) pokeapi.EvolutionDetail {
+		detail := levelUp(0)
+		detail.Location = Ref[pokeapi.Location]("location", locationID, location)
+		return detail
+	}
+	affection := levelUp(0)
+	affection.MinAffection = 2
+	affection.KnownMoveType = Ref[pokeapi.Type]("type", 18, "fairy")
+	pichuToPikachu := levelUp(0)
+	pichuToPikachu.MinHappiness = 220
+
+	dataset.EvolutionChains = []pokeapi.EvolutionChain{
+		NewEvolutionChain(2, Evolution(4, "charmander", nil,
+			Evolution(5, "charmeleon", []pokeapi.EvolutionDetail{levelUp(16)},
+				Evolution(6, "charizard", []pokeapi.EvolutionDetail{levelUp(36)}),
+			),
+		), dataset.Species),
+		NewEvolutionChain(10, Evolution(172, "pichu", nil,
+			Evolution(25, "pikachu", []pokeapi.EvolutionDetail{pichuToPikachu},
+				Evolution(26, "raichu", []pokeapi.EvolutionDetail{useItem(83, "thunder-stone")}),
+			),
+		), dataset.Species),
+		NewEvolutionChain(67, Evolution(133, "eevee", nil,
+			Evolution(134, "vaporeon", []pokeapi.EvolutionDetail{useItem(84, "water-stone")}),
+			Evolution(135, "jolteon", []pokeapi.EvolutionDetail{useItem(83, "thunder-stone")}),
+			Evolution(136, "flareon", []pokeapi.EvolutionDetail{useItem(82, "fire-stone")}),
+			Evolution(196, "espeon", []pokeapi.EvolutionDetail{friendship("day")}),
+			Evolution(197, "umbreon", []pokeapi.EvolutionDetail{friendship("night")}),
+			Evolution(470, "leafeon", []pokeapi.EvolutionDetail{atLocation(8, "eterna-forest"), useItem(85, "leaf-stone")}),
+			Evolution(471, "glaceon", []pokeapi.EvolutionDetail{atLocation(48, "sinnoh-route-217"), useItem(885, "ice-stone")}),
+			Evolution(700, "sylveon", []pokeapi.EvolutionDetail{affection}),
+		), dataset.Species),
+	}
+
+	// Canalave City comes first so that it is on the first page, as on the real PokeAPI
+	areas := []struct {
+		name    string
+		pokemon []pokeapi.Pokemon
+	}{
+		{"canalave-city-area", []pokeapi.Pokemon{tentacool, magikarp}},
+		{"eterna-city-area", nil},
+		{"pastoria-city-area", []pokeapi.Pokemon{tentacool, magikarp}},
+		{"sunyshore-city-area", []pokeapi.Pokemon{tentacool}},
+		{"sinnoh-pokemon-league-area", nil},
+		{"oreburgh-mine-1f", []pokeapi.Pokemon{zubat, geodude}},
+		{"oreburgh-mine-b1f", []pokeapi.Pokemon{zubat, geodude}},
+		{"valley-windworks-area", []pokeapi.Pokemon{pikachu}},
+		{"eterna-forest-area", []pokeapi.Pokemon{pidgey, bulbasaur}},
+		{"fuego-ironworks-area", []pokeapi.Pokemon{charmander}},
+		{"mt-coronet-1f-route-207", []pokeapi.Pokemon{zubat, geodude}},
+		{"mt-coronet-2f", []pokeapi.Pokemon{zubat}},
+		{"mt-coronet-3f", []pokeapi.Pokemon{zubat}},
+		{"mt-coronet-exterior-snowfall", nil},
+		{"mt-coronet-exterior-blizzard", nil},
+		{"mt-coronet-4f", []pokeapi.Pokemon{zubat}},
+		{"mt-coronet-4f-small-room", nil},
+		{"mt-coronet-5f", []pokeapi.Pokemon{zubat}},
+		{"mt-coronet-6f", nil},
+		{"mt-coronet-1f-from-exterior", []pokeapi.Pokemon{geodude}},
+		{"mt-coronet-1f-route-216", []pokeapi.Pokemon{geodude}},
+		{"mt-coronet-1f-route-211", []pokeapi.Pokemon{geodude}},
+		{"mt-coronet-b1f", []pokeapi.Pokemon{zubat}},
+		{"great-marsh-area-1", []pokeapi.Pokemon{squirtle}},
+		{"great-marsh-area-2", []pokeapi.Pokemon{squirtle}},
+		{"great-marsh-area-3", nil},
+		{"great-marsh-area-4", nil},
+		{"great-marsh-area-5", nil},
+		{"great-marsh-area-6", nil},
+		{"solaceon-ruins-2f", nil},
+		{"solaceon-ruins-1f", nil},
+		{"solaceon-ruins-b1f-a", nil},
+		{"solaceon-ruins-b1f-b", nil},
+		{"solaceon-ruins-b1f-c", nil},
+		{"solaceon-ruins-b2f-a", nil},
+		{"solaceon-ruins-b2f-b", nil},
+		{"solaceon-ruins-b2f-c", nil},
+		{"solaceon-ruins-b3f-a", nil},
+		{"solaceon-ruins-b3f-b", nil},
+		{"solaceon-ruins-b3f-c", nil},
+		{"solaceon-ruins-b3f-d", nil},
+		{"solaceon-ruins-b3f-e", nil},
+		{"solaceon-ruins-b4f-a", nil},
+		{"solaceon-ruins-b4f-b", nil},
+		{"solaceon-ruins-b4f-c", nil},
+	}
+	for i, area := range areas {
+		dataset.LocationAreas = append(dataset.LocationAreas, NewLocationArea(i+1, area.name, area.pokemon...))
+	}
+	return dataset
+}
@@ -0,0 +1,179 @@
+// Package pokeapitest provides a fake PokeAPI for tests: an httptest.Server that serves an
+// in-memory dataset with the real URL layout, pagination and 404s.
+package pokeapitest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	pokeapi "github.com/avgra3/pokedexcli/internal/pokeapi"
+)
+
+// apiPath is where the API lives on the server, as on pokeapi.co
+const apiPath = "/api/v2"
+
+// Server is a running fake PokeAPI. Close it when done.
+type Server struct {
+	*httptest.Server
+	mu        sync.Mutex
+	resources map[string][]resource
+	latency   time.Duration
+	requests  atomic.Int64
+}
+
+// resource is one stored resource, already encoded
+type resource struct {
+	id   int
+	name string
+	body []byte
+}
+
+// NewServer starts a server serving dataset
+func NewServer(dataset Dataset) *Server {
+	server := &Server{resources: make(map[string][]resource)}
+	for _, pokemon := range dataset.Pokemon {
+		server.Add("pokemon", pokemon.Id, pokemon.Name, pokemon)
+	}
+	for _, species := range dataset.Species {
+		server.Add("pokemon-species", species.Id, species.Name, species)
+	}
+	for _, locationArea := range dataset.LocationAreas {
+		server.Add("location-area", locationArea.Id, locationArea.Name, locationArea)
+	}
+	for _, pokemonType := range dataset.Types {
+		server.Add("type", pokemonType.Id, pokemonType.Name, pokemonType)
+	}
+	for _, move := range dataset.Moves {
+		server.Add("move", move.Id, move.Name, move)
+	}
+	for _, item := range dataset.Items {
+		server.Add("item", item.Id, item.Name, item)
+	}
+	for _, evolutionChain := range dataset.EvolutionChains {
+		// Evolution chains have no name, only an id
+		server.Add("evolution-chain", evolutionChain.Id, "", evolutionChain)
+	}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serve))
+	return server
+}
+
+// BaseURL is the URL to give pokeapi.WithBaseURL
+func (s *Server) BaseURL() string {
+	return s.URL + apiPath
+}
+
+// ClientOptions points a pokeapi.Client at the server, followed by any extra opts.
+// Rate limiting is off, there is nobody to be polite to.
+func (s *Server) ClientOptions(opts ...pokeapi.ClientOption) []pokeapi.ClientOption {
+	return append([]pokeapi.ClientOption{
+		pokeapi.WithBaseURL(s.BaseURL()),
+		pokeapi.WithHTTPClient(s.Server.Client()),
+		pokeapi.WithRateLimit(0, 0),
+	}, opts...)
+}
+
+// Add stores any resource, e.g. Add("type", 13, "electric", electricType).
+// References to pokeapi.DefaultBaseURL inside it are rewritten to point at the server.
+func (s *Server) Add(resourceName string, id int, name string, value any) {
+	body, err := json.Marshal(value)
+	if err != nil {
+		panic(fmt.Sprintf("pokeapitest: cannot encode %v %v: %v", resourceName, name, err))
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := s.resources[resourceName]
+	for i, existing := range stored {
+		if existing.id == id {
+			stored[i] = resource{id: id, name: name, body: body}
+			return
+		}
+	}
+	stored = append(stored, resource{id: id, name: name, body: body})
+	sort.Slice(stored, func(i, j int) bool { return stored[i].id < stored[j].id })
+	s.resources[resourceName] = stored
+}
+
+// SetLatency makes every response wait d first, to exercise timeouts and cancellation
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = d
+}
+
+// Requests is how many requests the server has received
+func (s *Server) Requests() int64 {
+	return s.requests.Load()
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	s.requests.Add(1)
+	s.mu.Lock()
+	latency := s.latency
+	s.mu.Unlock()
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, apiPath), "/"), "/")
+	if !strings.HasPrefix(r.URL.Path, apiPath+"/") || len(segments) > 2 {
+		http.NotFound(w, r)
+		return
+	}
+	s.mu.Lock()
+	stored, ok := s.resources[segments[0]]
+	s.mu.Unlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	if len(segments) == 1 {
+		s.serveList(w, r, segments[0], stored)
+		return
+	}
+	for _, candidate := range stored {
+		if candidate.name == segments[1] || strconv.Itoa(candidate.id) == segments[1] {
+			s.writeJSON(w, candidate.body)
+			return
+		}
+	}
+	http.NotFound(w, r)
+}
+
+func (s *Server) serveList(w http.ResponseWriter, r *http.Request, resourceName string, stored []resource) {
+	results := make([]pokeapi.NamedAPIResource[any], 0, len(stored))
+	for _, item := range stored {
+		results = append(results, pokeapi.NamedAPIResource[any]{
+			Name: item.name,
+			URL:  fmt.Sprintf("%v/%v/%d/", pokeapi.DefaultBaseURL, resourceName, item.id),
+		})
+	}
+	// Links point at pokeapi.co like everything else, writeJSON points them here
+	page := pokeapi.ListPage(r.URL.Query(), results, func(offset, limit int) string {
+		return fmt.Sprintf("%v/%v?offset=%d&limit=%d", pokeapi.DefaultBaseURL, resourceName, offset, limit)
+	})
+	body, err := json.Marshal(page)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.writeJSON(w, body)
+}
+
+// writeJSON sends body with references pointed at this server rather than pokeapi.co
+func (s *Server) writeJSON(w http.ResponseWriter, body []byte) {
+	body = []byte(strings.ReplaceAll(string(body), pokeapi.DefaultBaseURL, s.BaseURL()))
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Write(body)
+}
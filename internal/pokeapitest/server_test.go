@@ -0,0 +1,71 @@
+package pokeapitest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	pokeapi "github.com/avgra3/pokedexcli/internal/pokeapi"
+	pokecache "github.com/avgra3/pokedexcli/internal/pokecache"
+)
+
+func TestServer(t *testing.T) {
+	server := NewServer(DefaultDataset())
+	defer server.Close()
+	client := pokeapi.NewClient(pokecache.NewCache(), server.ClientOptions()...)
+	ctx := context.Background()
+
+	first, err := client.LocationAreas(ctx, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Results) != 20 || first.Results[0].Name != "canalave-city-area" || first.Previous != "" {
+		t.Fatalf("unexpected first page: %+v", first)
+	}
+	if !strings.HasPrefix(first.Next, server.BaseURL()) {
+		t.Fatalf("next page %q does not point at the server", first.Next)
+	}
+	second, err := client.LocationAreas(ctx, first.Next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.Results[0].Name != "mt-coronet-1f-route-216" || second.Previous == "" {
+		t.Fatalf("second page has no previous page: %+v", second)
+	}
+
+	pokemon, err := client.Pokemon(ctx, "25")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pokemon.Name != "pikachu" {
+		t.Fatalf("expected pikachu, got %v", pokemon.Name)
+	}
+	species, err := pokemon.Species.Resolve(ctx, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if species.CaptureRate != 190 {
+		t.Fatalf("expected a capture rate of 190, got %v", species.CaptureRate)
+	}
+
+	if _, err := client.Pokemon(ctx, "pikachuu"); !errors.Is(err, pokeapi.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestServerLatency(t *testing.T) {
+	server := NewServer(DefaultDataset())
+	defer server.Close()
+	server.SetLatency(time.Second)
+	client := pokeapi.NewClient(pokecache.NewCache(), server.ClientOptions(
+		pokeapi.WithRequestTimeout(10*time.Millisecond),
+		pokeapi.WithRetryPolicy(pokeapi.RetryPolicy{MaxAttempts: 1}),
+	)...)
+
+	_, err := client.Pokemon(context.Background(), "pikachu")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the request to time out, got %v", err)
+	}
+}
@@ -0,0 +1,132 @@
+package pokecache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	atomicfile "github.com/avgra3/pokedexcli/internal/atomicfile"
+)
+
+// Backend is a slower, longer lived tier behind the in-memory cache.
+// The cache calls it without holding its own lock, so it must be safe for concurrent use.
+type Backend interface {
+	// Load returns the entry stored under key, if there is one
+	Load(key string) (Entry, bool)
+	Store(key string, entry Entry) error
+	Delete(key string) error
+	// Clear removes every entry
+	Clear() error
+}
+
+// Entry is a cached value along with when it was cached
+type Entry struct {
+	CreatedAt time.Time
+	Val       []byte
+	// ETag and LastModified are the response's validators, if it had any
+	ETag         string
+	LastModified string
+}
+
+// FileBackend keeps one file per key in a directory, so entries survive restarts.
+// Entries are replaced by renaming a complete file over the old one, so it is safe for concurrent use.
+type FileBackend struct {
+	dir string
+}
+
+// fileEntry is the on-disk format of a single entry
+type fileEntry struct {
+	Key          string    `json:"key"`
+	CreatedAt    time.Time `json:"created_at"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	Val          []byte    `json:"val"`
+}
+
+// DefaultDir is where the CLI keeps its cache: $XDG_CACHE_HOME/pokedexcli,
+// or the platform equivalent
+func DefaultDir() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cacheDir, "pokedexcli"), nil
+}
+
+// NewFileBackend stores entries under dir, creating it if needed
+func NewFileBackend(dir string) (*FileBackend, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+	return &FileBackend{dir: dir}, nil
+}
+
+func (b *FileBackend) Load(key string) (Entry, bool) {
+	data, err := os.ReadFile(b.path(key))
+	if err != nil {
+		return Entry{}, false
+	}
+	stored := fileEntry{}
+	err = json.Unmarshal(data, &stored)
+	// A damaged file or a hash collision is treated as a miss
+	if err != nil || stored.Key != key {
+		return Entry{}, false
+	}
+	return Entry{
+		CreatedAt:    stored.CreatedAt,
+		Val:          stored.Val,
+		ETag:         stored.ETag,
+		LastModified: stored.LastModified,
+	}, true
+}
+
+func (b *FileBackend) Store(key string, entry Entry) error {
+	data, err := json.Marshal(fileEntry{
+		Key:          key,
+		CreatedAt:    entry.CreatedAt,
+		ETag:         entry.ETag,
+		LastModified: entry.LastModified,
+		Val:          entry.Val,
+	})
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(b.path(key), data)
+}
+
+func (b *FileBackend) Delete(key string) error {
+	err := os.Remove(b.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (b *FileBackend) Clear() error {
+	files, err := os.ReadDir(b.dir)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		// Only touch our own files, the directory might be shared
+		if file.IsDir() || filepath.Ext(file.Name()) != ".json" {
+			continue
+		}
+		err := os.Remove(filepath.Join(b.dir, file.Name()))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// path hashes the key, since URLs are not valid file names
+func (b *FileBackend) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(b.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -1,69 +1,311 @@
 package pokecache
 
 import (
+	"container/list"
 	"sync"
 	"time"
 )
 
-func NewCache(interval time.Duration) *Cache {
+// Option configures a Cache created by NewCache
+type Option func(*Cache)
+
+// WithInterval expires entries once they are older than interval, checking every interval.
+// Without it entries only leave the cache through eviction.
+func WithInterval(interval time.Duration) Option {
+	return func(c *Cache) {
+		c.interval = interval
+	}
+}
+
+// WithStaleWhileRevalidate keeps entries around for window after they expire.
+// During that window Lookup reports them as Stale, so they can be served while being refreshed.
+func WithStaleWhileRevalidate(window time.Duration) Option {
+	return func(c *Cache) {
+		c.staleWindow = window
+	}
+}
+
+// WithClock replaces the wall clock, mostly useful for tests
+func WithClock(clock Clock) Option {
+	return func(c *Cache) {
+		c.clock = clock
+	}
+}
+
+// WithBackend puts a persistent tier behind the in-memory cache.
+// Added entries are written through to it and misses fall back to it.
+func WithBackend(backend Backend) Option {
+	return func(c *Cache) {
+		c.backend = backend
+	}
+}
+
+// WithMaxEntries caps how many entries are kept in memory, evicting the least recently used
+func WithMaxEntries(maxEntries int) Option {
+	return func(c *Cache) {
+		c.maxEntries = maxEntries
+	}
+}
+
+// WithMaxBytes caps the size of the entries kept in memory (keys plus values),
+// evicting the least recently used
+func WithMaxBytes(maxBytes int) Option {
+	return func(c *Cache) {
+		c.maxBytes = maxBytes
+	}
+}
+
+// NewCache creates a cache. If it expires entries (WithInterval) it must be closed once no longer needed.
+func NewCache(opts ...Option) *Cache {
 	cache := &Cache{
-		cacheEntries: make(map[string]cacheEntry),
+		cacheEntries: make(map[string]*list.Element),
+		recency:      list.New(),
+		clock:        realClock{},
+		done:         make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(cache)
+	}
+	if cache.interval > 0 {
+		cache.reapLoop(cache.interval)
 	}
-	cache.reapLoop(interval)
 	return cache
 }
 
 type Cache struct {
 	mu           sync.Mutex
-	cacheEntries map[string]cacheEntry
+	cacheEntries map[string]*list.Element
+	// recency orders entries from most (front) to least (back) recently used
+	recency     *list.List
+	totalBytes  int
+	interval    time.Duration // zero means entries never expire
+	staleWindow time.Duration
+	maxEntries  int // zero means no limit
+	maxBytes    int // zero means no limit
+	backend     Backend
+	stats       Stats
+	clock       Clock
+	done        chan struct{}
+	closeOnce   sync.Once
 }
 
+// Freshness says how usable a cached entry is
+type Freshness int
+
+const (
+	// Fresh entries are younger than the interval
+	Fresh Freshness = iota
+	// Stale entries are past the interval but within the stale window: serve them and refresh in the background
+	Stale
+	// Expired entries are past both; they are only good for revalidating with the server
+	Expired
+)
+
 type cacheEntry struct {
+	key       string
 	createdAt time.Time
 	val       []byte // raw data we're caching
+	// validators from the response, used to ask the server whether val is still current
+	etag         string
+	lastModified string
+}
+
+func (e *cacheEntry) export() Entry {
+	return Entry{CreatedAt: e.createdAt, Val: e.val, ETag: e.etag, LastModified: e.lastModified}
+}
+
+func (e *cacheEntry) size() int {
+	return len(e.key) + len(e.val)
 }
 
 func (c *Cache) Add(key string, value []byte) {
-	entry := cacheEntry{
-		createdAt: time.Now(),
-		val:       value,
+	c.AddEntry(key, Entry{Val: value})
+}
+
+// AddEntry caches an entry along with its validators. A zero CreatedAt means now.
+func (c *Cache) AddEntry(key string, stored Entry) {
+	entry := &cacheEntry{
+		key:          key,
+		createdAt:    stored.CreatedAt,
+		val:          stored.Val,
+		etag:         stored.ETag,
+		lastModified: stored.LastModified,
+	}
+	if entry.createdAt.IsZero() {
+		entry.createdAt = c.clock.Now()
 	}
 	c.mu.Lock()
-	defer c.mu.Unlock()
-	c.cacheEntries[key] = entry
+	c.store(entry)
+	c.mu.Unlock()
+	if c.backend != nil {
+		// The persistent tier is best effort, the entry is still cached in memory.
+		// Disk I/O happens outside the lock so lookups of other keys don't wait for it.
+		c.backend.Store(key, entry.export())
+	}
 }
 
 func (c *Cache) Get(key string) ([]byte, bool) {
 	// Get entry from the cache
 	// If the entry is found, return true
 	// Otherwise return false
-	result, ok := c.cacheEntries[key]
+	entry, _, ok := c.Lookup(key)
+	return entry.Val, ok
+}
+
+// Lookup returns the entry for key along with how fresh it is.
+// Expired entries are still returned, for their validators, but count as misses.
+func (c *Cache) Lookup(key string) (Entry, Freshness, bool) {
+	c.mu.Lock()
+	element, ok := c.cacheEntries[key]
 	if ok {
-		return result.val, ok
+		c.recency.MoveToFront(element)
+		entry := element.Value.(*cacheEntry)
+		freshness := c.freshness(entry.createdAt)
+		c.count(freshness)
+		c.mu.Unlock()
+		return entry.export(), freshness, ok
+	}
+	if c.backend == nil {
+		c.stats.Misses++
+		c.mu.Unlock()
+		return Entry{}, Expired, ok
+	}
+	c.mu.Unlock()
+
+	// Fall back to the persistent tier, without holding the lock while reading the disk
+	stored, ok := c.backend.Load(key)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !ok {
+		c.stats.Misses++
+		return Entry{}, Expired, ok
+	}
+	freshness := c.freshness(stored.CreatedAt)
+	c.count(freshness)
+	// An expired entry is only good for revalidating, so it is not worth memory.
+	// Someone may also have cached a newer entry while we were reading, which wins.
+	if _, cached := c.cacheEntries[key]; !cached && freshness != Expired {
+		c.store(&cacheEntry{
+			key:          key,
+			createdAt:    stored.CreatedAt,
+			val:          stored.Val,
+			etag:         stored.ETag,
+			lastModified: stored.LastModified,
+		})
+	}
+	return stored, freshness, ok
+}
+
+// count records a lookup that found an entry: only a usable one is a hit.
+// The caller must hold c.mu.
+func (c *Cache) count(freshness Freshness) {
+	if freshness == Expired {
+		c.stats.Misses++
+		return
+	}
+	c.stats.Hits++
+}
+
+// Touch marks the entry for key as created now, e.g. after the server confirmed it is unchanged
+func (c *Cache) Touch(key string) bool {
+	c.mu.Lock()
+	element, ok := c.cacheEntries[key]
+	if !ok {
+		c.mu.Unlock()
+		return false
+	}
+	entry := element.Value.(*cacheEntry)
+	entry.createdAt = c.clock.Now()
+	c.recency.MoveToFront(element)
+	stored := entry.export()
+	c.mu.Unlock()
+	if c.backend != nil {
+		c.backend.Store(key, stored)
+	}
+	return true
+}
+
+func (c *Cache) freshness(createdAt time.Time) Freshness {
+	if c.interval <= 0 {
+		return Fresh
+	}
+	age := c.clock.Now().Sub(createdAt)
+	if age <= c.interval {
+		return Fresh
 	}
-	return nil, ok
+	if age <= c.interval+c.staleWindow {
+		return Stale
+	}
+	return Expired
+}
+
+// store puts entry in memory as the most recently used, then evicts down to the limits.
+// The caller must hold c.mu.
+func (c *Cache) store(entry *cacheEntry) {
+	if element, ok := c.cacheEntries[entry.key]; ok {
+		c.remove(element)
+	}
+	c.cacheEntries[entry.key] = c.recency.PushFront(entry)
+	c.totalBytes += entry.size()
+	for c.overLimit() {
+		c.remove(c.recency.Back())
+		c.stats.Evictions++
+	}
+}
+
+func (c *Cache) overLimit() bool {
+	if c.recency.Len() == 0 {
+		return false
+	}
+	if c.maxEntries > 0 && c.recency.Len() > c.maxEntries {
+		return true
+	}
+	return c.maxBytes > 0 && c.totalBytes > c.maxBytes
+}
+
+// remove drops an entry from memory. The caller must hold c.mu.
+func (c *Cache) remove(element *list.Element) {
+	entry := c.recency.Remove(element).(*cacheEntry)
+	delete(c.cacheEntries, entry.key)
+	c.totalBytes -= entry.size()
+}
+
+// Close stops the background expiry of entries. The cache can still be used afterwards.
+func (c *Cache) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+	return nil
 }
 
 func (c *Cache) reapLoop(interval time.Duration) {
-	// Each time an interval (the time.Duration passed to NewCache) passes it should remove any entries that are older than the interval
+	// Each time an interval (set with WithInterval) passes it should remove any entries that are older than the interval
 	// Example: If the interval is 5 seconds, and an entry was added 7 seconds ago, that entry should be removed
+	// Entries only leave memory; the backend (if any) keeps its copy
+	// With a stale window, entries are kept until they are older than the interval plus the window
 	// Create a new ticker that sends a value on its channel (ticker.C) at regular intervals (which we specify when we create a new ticker)
-	ticker := time.NewTicker(interval)
+	ticker := c.clock.NewTicker(interval)
 	// Anonymous Go routine that will run in the background
 	go func() {
-		// Runs indefinitely, waiting for each tick
+		defer ticker.Stop()
+		// Runs until the cache is closed, waiting for each tick
 		for {
 			// Wait until next tick
-			<-ticker.C
+			select {
+			case <-c.done:
+				return
+			case <-ticker.C():
+			}
 			// Lock the mutext before accessing the map - ensures thread safety
 			c.mu.Lock()
 			// Get current time
-			now := time.Now()
+			now := c.clock.Now()
 			// Check entry in the map
-			for key, entry := range c.cacheEntries {
-				// If the entry is older than the interval, delete it
-				if now.Sub(entry.createdAt) > interval {
-					delete(c.cacheEntries, key)
+			for _, element := range c.cacheEntries {
+				// If the entry is older than the interval (and stale window), delete it
+				if now.Sub(element.Value.(*cacheEntry).createdAt) > interval+c.staleWindow {
+					c.remove(element)
+					c.stats.Evictions++
 				}
 			}
 			// Unlock mutex
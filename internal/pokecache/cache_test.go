@@ -2,6 +2,7 @@ package pokecache
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 )
@@ -25,7 +26,8 @@ func TestCaching(t *testing.T) {
 	for i, c := range cases {
 		caseMessage := fmt.Sprintf("Test case %v", i)
 		t.Run(caseMessage, func(t *testing.T) {
-			cache := NewCache(interval)
+			cache := NewCache(WithInterval(interval))
+			defer cache.Close()
 			cache.Add(c.key, c.val)
 			val, ok := cache.Get(c.key)
 			if !ok {
@@ -43,7 +45,9 @@ func TestCaching(t *testing.T) {
 func TestReapLoop(t *testing.T) {
 	const baseTime = 5 * time.Millisecond
 	const waitTime = baseTime + 5*time.Millisecond
-	cache := NewCache(baseTime)
+	clock := newFakeClock()
+	cache := NewCache(WithInterval(baseTime), WithClock(clock))
+	defer cache.Close()
 	cache.Add("https://example.com", []byte("testdata"))
 
 	_, ok := cache.Get("https://example.com")
@@ -52,10 +56,270 @@ func TestReapLoop(t *testing.T) {
 		return
 	}
 
-	time.Sleep(waitTime)
+	clock.Advance(waitTime)
 	_, ok = cache.Get("https://example.com")
 	if ok {
 		t.Errorf("expected to not find a key")
 		return
 	}
 }
+
+func TestClose(t *testing.T) {
+	clock := newFakeClock()
+	cache := NewCache(WithInterval(time.Millisecond), WithClock(clock))
+	cache.Close()
+	// Closing twice is fine
+	cache.Close()
+
+	select {
+	case <-clock.stopped:
+	case <-time.After(time.Second):
+		t.Errorf("expected the reap loop to stop its ticker")
+	}
+}
+
+// fakeClock only moves when told to
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	ticks   chan time.Time
+	stopped chan struct{}
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{
+		now:     time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		ticks:   make(chan time.Time),
+		stopped: make(chan struct{}),
+	}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) NewTicker(d time.Duration) Ticker {
+	return fakeTicker{clock: c}
+}
+
+// Advance moves time forward and fires the ticker
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	now := c.now
+	c.mu.Unlock()
+	// The ticker channel is unbuffered and the reap loop only takes the next tick
+	// once it is done with the previous one, so after the second send the first
+	// reap has finished
+	c.ticks <- now
+	c.ticks <- now
+}
+
+type fakeTicker struct {
+	clock *fakeClock
+}
+
+func (t fakeTicker) C() <-chan time.Time {
+	return t.clock.ticks
+}
+
+func (t fakeTicker) Stop() {
+	close(t.clock.stopped)
+}
+
+func TestFileBackend(t *testing.T) {
+	dir := t.TempDir()
+	backend, err := NewFileBackend(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cache := NewCache(WithBackend(backend))
+	cache.Add("https://example.com", []byte("testdata"))
+
+	// A fresh cache over the same directory, as if the CLI was restarted
+	backend, err = NewFileBackend(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	restarted := NewCache(WithBackend(backend))
+	val, ok := restarted.Get("https://example.com")
+	if !ok {
+		t.Errorf("expected to find key after restart")
+		return
+	}
+	if string(val) != "testdata" {
+		t.Errorf("expected to find value")
+		return
+	}
+
+	stored, ok := backend.Load("https://example.com")
+	if !ok || stored.CreatedAt.IsZero() {
+		t.Errorf("expected createdAt to be kept on disk: %+v", stored)
+	}
+	err = backend.Delete("https://example.com")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, ok := backend.Load("https://example.com"); ok {
+		t.Errorf("expected to not find a key")
+	}
+}
+
+// blockingBackend holds every Load until release is closed
+type blockingBackend struct {
+	loading chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingBackend) Load(key string) (Entry, bool) {
+	b.loading <- struct{}{}
+	<-b.release
+	return Entry{}, false
+}
+
+func (b *blockingBackend) Store(key string, entry Entry) error { return nil }
+func (b *blockingBackend) Delete(key string) error             { return nil }
+func (b *blockingBackend) Clear() error                        { return nil }
+
+func TestBackendOutsideLock(t *testing.T) {
+	backend := &blockingBackend{loading: make(chan struct{}), release: make(chan struct{})}
+	cache := NewCache(WithBackend(backend))
+	cache.Add("in-memory", []byte("1"))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		cache.Get("on-disk")
+	}()
+	<-backend.loading
+
+	// While the disk is busy, memory is still there for everyone else
+	got := make(chan bool)
+	go func() {
+		_, ok := cache.Get("in-memory")
+		cache.Add("other", []byte("2"))
+		got <- ok
+	}()
+	select {
+	case ok := <-got:
+		if !ok {
+			t.Errorf("expected to find the in-memory entry")
+		}
+	case <-time.After(time.Second):
+		t.Errorf("expected the cache not to wait for a slow backend")
+	}
+	close(backend.release)
+	<-done
+}
+
+func TestLRUEviction(t *testing.T) {
+	cache := NewCache(WithMaxEntries(2))
+	cache.Add("a", []byte("1"))
+	cache.Add("b", []byte("2"))
+	// Touch "a" so "b" becomes the least recently used
+	cache.Get("a")
+	cache.Add("c", []byte("3"))
+
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("expected b to be evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := cache.Get(key); !ok {
+			t.Errorf("expected to find %v", key)
+		}
+	}
+
+	// Each entry is 1 byte of key plus 4 bytes of value
+	cache = NewCache(WithMaxBytes(10))
+	cache.Add("a", []byte("1111"))
+	cache.Add("b", []byte("2222"))
+	cache.Add("c", []byte("3333"))
+	if _, ok := cache.Get("a"); ok {
+		t.Errorf("expected a to be evicted to stay under the byte budget")
+	}
+	if _, ok := cache.Get("c"); !ok {
+		t.Errorf("expected to find c")
+	}
+}
+
+func TestStats(t *testing.T) {
+	backend, err := NewFileBackend(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cache := NewCache(WithMaxEntries(1), WithBackend(backend))
+	cache.Add("a", []byte("1"))
+	cache.Get("a")
+	cache.Get("missing")
+	// Pushes "a" out of memory, but it is still on disk
+	cache.Add("b", []byte("22"))
+
+	stats := cache.Stats()
+	expected := Stats{Hits: 1, Misses: 1, Evictions: 1, Entries: 1, Bytes: 3}
+	if stats != expected {
+		t.Errorf("Expected: %+v; Got: %+v", expected, stats)
+	}
+	entries := cache.Entries()
+	if len(entries) != 1 || entries[0].Key != "b" {
+		t.Errorf("unexpected entries: %+v", entries)
+	}
+
+	found, err := cache.Delete("b")
+	if !found || err != nil {
+		t.Errorf("expected to delete b: %v %v", found, err)
+	}
+	err = cache.Clear()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, ok := cache.Get("a"); ok {
+		t.Errorf("expected clear to empty the backend too")
+	}
+}
+
+func TestStaleWhileRevalidate(t *testing.T) {
+	clock := newFakeClock()
+	cache := NewCache(WithInterval(time.Minute), WithStaleWhileRevalidate(time.Minute), WithClock(clock))
+	defer cache.Close()
+	cache.AddEntry("https://example.com", Entry{Val: []byte("testdata"), ETag: `"v1"`})
+
+	clock.Advance(90 * time.Second)
+	entry, freshness, ok := cache.Lookup("https://example.com")
+	if !ok || freshness != Stale || entry.ETag != `"v1"` {
+		t.Errorf("expected a stale entry to be kept with its ETag: %+v %v %v", entry, freshness, ok)
+	}
+
+	cache.Touch("https://example.com")
+	_, freshness, _ = cache.Lookup("https://example.com")
+	if freshness != Fresh {
+		t.Errorf("expected Touch to make the entry fresh; Got: %v", freshness)
+	}
+
+	clock.Advance(3 * time.Minute)
+	if _, ok := cache.Get("https://example.com"); ok {
+		t.Errorf("expected the entry to be reaped after the stale window")
+	}
+}
+
+func TestExpiredBackendEntry(t *testing.T) {
+	backend, err := NewFileBackend(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clock := newFakeClock()
+	backend.Store("https://example.com", Entry{CreatedAt: clock.Now().Add(-time.Hour), Val: []byte("old"), ETag: `"v1"`})
+	cache := NewCache(WithInterval(time.Minute), WithStaleWhileRevalidate(time.Minute), WithClock(clock), WithBackend(backend))
+	defer cache.Close()
+
+	entry, freshness, ok := cache.Lookup("https://example.com")
+	if !ok || freshness != Expired || entry.ETag != `"v1"` {
+		t.Errorf("expected the expired entry for its validators: %+v %v %v", entry, freshness, ok)
+	}
+	stats := cache.Stats()
+	expected := Stats{Misses: 1}
+	if stats != expected {
+		t.Errorf("expected an expired entry to count as a miss and stay out of memory; Expected: %+v; Got: %+v", expected, stats)
+	}
+}
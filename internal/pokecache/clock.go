@@ -0,0 +1,38 @@
+package pokecache
+
+import "time"
+
+// Clock is the cache's source of time, replaceable so tests don't have to sleep
+type Clock interface {
+	Now() time.Time
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker is the part of *time.Ticker the cache uses
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// realClock is the wall clock
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+type realTicker struct {
+	ticker *time.Ticker
+}
+
+func (t realTicker) C() <-chan time.Time {
+	return t.ticker.C
+}
+
+func (t realTicker) Stop() {
+	t.ticker.Stop()
+}
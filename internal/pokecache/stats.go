@@ -0,0 +1,69 @@
+package pokecache
+
+import "time"
+
+// Stats describes how the cache has been used
+type Stats struct {
+	Hits   int
+	Misses int
+	// Evictions counts entries dropped from memory for being too old or over the size limits
+	Evictions int
+	// Entries and Bytes describe what is in memory right now
+	Entries int
+	Bytes   int
+}
+
+// EntryInfo describes a single entry held in memory
+type EntryInfo struct {
+	Key       string
+	CreatedAt time.Time
+	Size      int
+}
+
+func (c *Cache) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats
+	stats.Entries = c.recency.Len()
+	stats.Bytes = c.totalBytes
+	return stats
+}
+
+// Entries lists what is held in memory, most recently used first
+func (c *Cache) Entries() []EntryInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entries := make([]EntryInfo, 0, c.recency.Len())
+	for element := c.recency.Front(); element != nil; element = element.Next() {
+		entry := element.Value.(*cacheEntry)
+		entries = append(entries, EntryInfo{Key: entry.key, CreatedAt: entry.createdAt, Size: entry.size()})
+	}
+	return entries
+}
+
+// Delete removes key from memory and the backend, reporting whether it was in memory
+func (c *Cache) Delete(key string) (bool, error) {
+	c.mu.Lock()
+	element, ok := c.cacheEntries[key]
+	if ok {
+		c.remove(element)
+	}
+	c.mu.Unlock()
+	if c.backend != nil {
+		return ok, c.backend.Delete(key)
+	}
+	return ok, nil
+}
+
+// Clear removes every entry from memory and the backend
+func (c *Cache) Clear() error {
+	c.mu.Lock()
+	for _, element := range c.cacheEntries {
+		c.remove(element)
+	}
+	c.mu.Unlock()
+	if c.backend != nil {
+		return c.backend.Clear()
+	}
+	return nil
+}
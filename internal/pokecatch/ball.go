@@ -0,0 +1,77 @@
+package pokecatch
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrUnknownBall is returned for a ball pokecatch knows nothing about
+var ErrUnknownBall = errors.New("unknown ball")
+
+// Conditions are the circumstances of a throw that some balls depend on
+type Conditions struct {
+	// Types are the target's types, e.g. "water"
+	Types []string
+	// AlreadyCaught is set when the player has caught the target's species before
+	AlreadyCaught bool
+	// Dark is set at night or in a cave
+	Dark bool
+	// Turn is the turn of the battle the ball is thrown on, starting at 1; zero means 1
+	Turn int
+}
+
+// masterBallBonus is large enough for any Pokemon's catch value to reach maxCatchValue
+const masterBallBonus = maxCatchValue
+
+// ballBonuses are the multipliers of the balls, named as on the PokeAPI, from generation 7 on
+var ballBonuses = map[string]func(Conditions) float64{
+	"poke-ball":   func(Conditions) float64 { return 1 },
+	"great-ball":  func(Conditions) float64 { return 1.5 },
+	"ultra-ball":  func(Conditions) float64 { return 2 },
+	"master-ball": func(Conditions) float64 { return masterBallBonus },
+	"dusk-ball": func(conditions Conditions) float64 {
+		if conditions.Dark {
+			return 3
+		}
+		return 1
+	},
+	"quick-ball": func(conditions Conditions) float64 {
+		if conditions.Turn <= 1 {
+			return 5
+		}
+		return 1
+	},
+	"net-ball": func(conditions Conditions) float64 {
+		if slices.Contains(conditions.Types, "water") || slices.Contains(conditions.Types, "bug") {
+			return 3.5
+		}
+		return 1
+	},
+	"repeat-ball": func(conditions Conditions) float64 {
+		if conditions.AlreadyCaught {
+			return 3.5
+		}
+		return 1
+	},
+}
+
+// BallBonus returns the multiplier of a ball, e.g. "great-ball", thrown under conditions
+func BallBonus(ball string, conditions Conditions) (float64, error) {
+	bonus, ok := ballBonuses[strings.ToLower(ball)]
+	if !ok {
+		return 0, fmt.Errorf("%w: %v", ErrUnknownBall, ball)
+	}
+	return bonus(conditions), nil
+}
+
+// Balls lists the names of every ball BallBonus knows, sorted
+func Balls() []string {
+	balls := make([]string, 0, len(ballBonuses))
+	for ball := range ballBonuses {
+		balls = append(balls, ball)
+	}
+	slices.Sort(balls)
+	return balls
+}
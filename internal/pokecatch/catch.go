@@ -1,13 +1,116 @@
+// Package pokecatch decides whether a thrown ball catches a Pokemon, using the capture
+// formula of the mainline games from generation 6 on.
 package pokecatch
 
 import (
-	"math/rand"
+	"math"
+
+	random "github.com/avgra3/pokedexcli/internal/random"
+)
+
+// maxCatchValue is the modified catch rate at which a ball can't fail
+const maxCatchValue = 255
+
+// shakeChecks is how many checks a ball must pass: one per shake, plus one to click shut
+const shakeChecks = 4
+
+// Status bonuses of the target's status condition
+const (
+	StatusNone = 1.0
+	// StatusParalyzed also applies to poisoned and burned targets
+	StatusParalyzed = 1.5
+	// StatusAsleep also applies to frozen targets
+	StatusAsleep = 2.5
 )
 
-func SuccessfulCatch(baseExperience int) bool {
-	chances := rand.Intn(baseExperience * 2)
-	if chances >= baseExperience {
-		return true
+// Attempt is everything that goes into throwing a ball
+type Attempt struct {
+	// CaptureRate is the species' capture rate, from 3 (legendaries) to 255
+	CaptureRate int
+	// MaxHP and HP are the target's maximum and current HP; a weakened target is easier to catch.
+	// Zero HP means full health.
+	MaxHP int
+	HP    int
+	// BallBonus is the ball's multiplier, e.g. 1 for a Poke Ball or 2 for an Ultra Ball; zero means 1
+	BallBonus float64
+	// StatusBonus is one of the Status constants; zero means StatusNone
+	StatusBonus float64
+	// Registered is how many species the player has caught, which makes critical captures likelier
+	Registered int
+}
+
+// Result is what came of a throw
+type Result struct {
+	Caught bool
+	// Shakes is how many times the ball shook, from 0 to 3
+	Shakes int
+	// Critical is set for a critical capture, which needs only one check to succeed
+	Critical bool
+}
+
+// Throw throws a ball, rolling dice with rng (nil for one seeded at random)
+func Throw(attempt Attempt, rng random.Source) Result {
+	if rng == nil {
+		rng = random.NewRandom()
+	}
+	catchValue := CatchValue(attempt)
+	if catchValue <= 0 {
+		return Result{}
+	}
+	if catchValue >= maxCatchValue {
+		return Result{Caught: true, Shakes: shakeChecks - 1}
+	}
+
+	// Each check passes with probability (a/255)^(3/16), so all four together with (a/255)^(3/4)
+	threshold := int(65536 / math.Pow(maxCatchValue/catchValue, 0.1875))
+	critical := rng.IntN(256) < int(catchValue*criticalMultiplier(attempt.Registered)/6)
+	checks := shakeChecks
+	if critical {
+		checks = 1
+	}
+	result := Result{Critical: critical}
+	for range checks {
+		if rng.IntN(65536) >= threshold {
+			return result
+		}
+		result.Shakes++
+	}
+	result.Caught = true
+	result.Shakes = min(result.Shakes, shakeChecks-1)
+	return result
+}
+
+// CatchValue is the modified catch rate of an attempt, "a" in the games' formula.
+// At 255 or more the ball can't fail.
+func CatchValue(attempt Attempt) float64 {
+	ballBonus, statusBonus := attempt.BallBonus, attempt.StatusBonus
+	if ballBonus <= 0 {
+		ballBonus = 1
+	}
+	if statusBonus <= 0 {
+		statusBonus = StatusNone
+	}
+	maxHP := max(attempt.MaxHP, 1)
+	hp := maxHP
+	if attempt.HP > 0 {
+		hp = min(attempt.HP, maxHP)
+	}
+	return float64(3*maxHP-2*hp) * float64(attempt.CaptureRate) * ballBonus / float64(3*maxHP) * statusBonus
+}
+
+// criticalMultiplier grows with the number of species registered, as in the games
+func criticalMultiplier(registered int) float64 {
+	switch {
+	case registered > 600:
+		return 2.5
+	case registered > 450:
+		return 2
+	case registered > 300:
+		return 1.5
+	case registered > 150:
+		return 1
+	case registered > 30:
+		return 0.5
 	}
-	return false
+	return 0
 }
@@ -0,0 +1,129 @@
+package pokecatch
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	random "github.com/avgra3/pokedexcli/internal/random"
+)
+
+func TestCatchValue(t *testing.T) {
+	cases := []struct {
+		name     string
+		attempt  Attempt
+		expected float64
+	}{
+		{name: "full health", attempt: Attempt{CaptureRate: 255, MaxHP: 100, HP: 100}, expected: 85},
+		{name: "zero HP means full health", attempt: Attempt{CaptureRate: 255, MaxHP: 100}, expected: 85},
+		{name: "one HP left", attempt: Attempt{CaptureRate: 45, MaxHP: 100, HP: 1}, expected: 44.7},
+		{name: "ultra ball", attempt: Attempt{CaptureRate: 45, MaxHP: 30, HP: 30, BallBonus: 2}, expected: 30},
+		{name: "asleep", attempt: Attempt{CaptureRate: 45, MaxHP: 30, HP: 30, StatusBonus: StatusAsleep}, expected: 37.5},
+		{name: "no capture rate", attempt: Attempt{MaxHP: 30, HP: 30}, expected: 0},
+	}
+	for _, c := range cases {
+		actual := CatchValue(c.attempt)
+		if math.Abs(actual-c.expected) > 1e-9 {
+			t.Errorf("%v: Expected: %v; Got: %v", c.name, c.expected, actual)
+		}
+	}
+}
+
+func TestThrow(t *testing.T) {
+	rng := random.New(1)
+	for range 100 {
+		// Without a capture rate (or base experience, which used to panic) nothing is caught
+		if result := Throw(Attempt{MaxHP: 50}, rng); result.Caught || result.Shakes != 0 {
+			t.Fatalf("expected an uncatchable Pokemon to escape at once; Got: %+v", result)
+		}
+		// A master ball always catches
+		if result := Throw(Attempt{CaptureRate: 3, MaxHP: 50, BallBonus: 255}, rng); !result.Caught || result.Shakes != 3 {
+			t.Fatalf("expected a master ball to catch; Got: %+v", result)
+		}
+	}
+
+	// Overall the chance of a catch is (a/255)^(3/4)
+	const throws = 20000
+	for _, attempt := range []Attempt{
+		{CaptureRate: 45, MaxHP: 100, HP: 100},
+		{CaptureRate: 190, MaxHP: 100, HP: 100},
+		{CaptureRate: 45, MaxHP: 100, HP: 1, StatusBonus: StatusAsleep},
+	} {
+		caught := 0
+		for range throws {
+			result := Throw(attempt, rng)
+			if result.Caught {
+				caught++
+			}
+			if result.Shakes < 0 || result.Shakes > 3 {
+				t.Fatalf("unexpected result: %+v", result)
+			}
+		}
+		expected := math.Pow(CatchValue(attempt)/255, 0.75)
+		if actual := float64(caught) / throws; math.Abs(actual-expected) > 0.02 {
+			t.Errorf("%+v: expected a catch rate near %.3f; Got: %.3f", attempt, expected, actual)
+		}
+	}
+}
+
+func TestCriticalCapture(t *testing.T) {
+	rng := random.New(3)
+	criticals := 0
+	for range 1000 {
+		result := Throw(Attempt{CaptureRate: 45, MaxHP: 100, HP: 100}, rng)
+		if result.Critical {
+			criticals++
+		}
+	}
+	if criticals != 0 {
+		t.Errorf("expected no critical captures with an empty Pokedex; Got: %v", criticals)
+	}
+
+	for range 1000 {
+		result := Throw(Attempt{CaptureRate: 255, MaxHP: 100, HP: 50, Registered: 700}, rng)
+		if result.Critical {
+			criticals++
+			if result.Caught && result.Shakes != 1 {
+				t.Errorf("expected a critical capture to shake once; Got: %+v", result)
+			}
+		}
+	}
+	if criticals == 0 {
+		t.Errorf("expected critical captures with a full Pokedex")
+	}
+}
+
+func TestBallBonus(t *testing.T) {
+	cases := []struct {
+		ball       string
+		conditions Conditions
+		expected   float64
+	}{
+		{ball: "poke-ball", expected: 1},
+		{ball: "Great-Ball", expected: 1.5},
+		{ball: "ultra-ball", expected: 2},
+		{ball: "master-ball", expected: 255},
+		{ball: "dusk-ball", expected: 1},
+		{ball: "dusk-ball", conditions: Conditions{Dark: true}, expected: 3},
+		{ball: "quick-ball", expected: 5},
+		{ball: "quick-ball", conditions: Conditions{Turn: 2}, expected: 1},
+		{ball: "net-ball", conditions: Conditions{Types: []string{"normal", "flying"}}, expected: 1},
+		{ball: "net-ball", conditions: Conditions{Types: []string{"water", "poison"}}, expected: 3.5},
+		{ball: "repeat-ball", conditions: Conditions{AlreadyCaught: true}, expected: 3.5},
+	}
+	for _, c := range cases {
+		actual, err := BallBonus(c.ball, c.conditions)
+		if err != nil || actual != c.expected {
+			t.Errorf("BallBonus(%v, %+v): Expected: %v; Got: %v (%v)", c.ball, c.conditions, c.expected, actual, err)
+		}
+	}
+	if _, err := BallBonus("beast-ball", Conditions{}); !errors.Is(err, ErrUnknownBall) {
+		t.Errorf("expected ErrUnknownBall; Got: %v", err)
+	}
+
+	// The master ball catches even the hardest Pokemon
+	bonus, _ := BallBonus("master-ball", Conditions{})
+	if value := CatchValue(Attempt{CaptureRate: 3, MaxHP: 106, BallBonus: bonus}); value < maxCatchValue {
+		t.Errorf("expected the master ball to be sure to catch; Got a catch value of %v", value)
+	}
+}
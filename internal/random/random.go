@@ -0,0 +1,37 @@
+// Package random rolls the dice for game logic such as catches and battles.
+// A Source made from a seed always rolls the same numbers, so a session can be replayed exactly.
+package random
+
+import "math/rand/v2"
+
+// Source is where game logic gets its random numbers from
+type Source interface {
+	// IntN returns a number in [0, n). It panics if n <= 0.
+	IntN(n int) int
+}
+
+// Seeded is a Source that remembers the seed it was made from. It is not safe for concurrent use.
+type Seeded struct {
+	seed uint64
+	rand *rand.Rand
+}
+
+// New returns a Source that rolls the same numbers every time for the same seed
+func New(seed uint64) *Seeded {
+	return &Seeded{seed: seed, rand: rand.New(rand.NewPCG(seed, seed))}
+}
+
+// NewRandom returns a Source with a seed picked at random, which Seed reports
+// so that whatever happens can still be replayed
+func NewRandom() *Seeded {
+	return New(rand.Uint64())
+}
+
+// Seed returns the seed the Source was made from
+func (s *Seeded) Seed() uint64 {
+	return s.seed
+}
+
+func (s *Seeded) IntN(n int) int {
+	return s.rand.IntN(n)
+}
@@ -0,0 +1,34 @@
+package random
+
+import "testing"
+
+func TestSeeded(t *testing.T) {
+	first, second := New(42), New(42)
+	for range 100 {
+		if a, b := first.IntN(1000), second.IntN(1000); a != b {
+			t.Fatalf("expected the same seed to roll the same numbers; Got: %v and %v", a, b)
+		}
+	}
+	if seed := first.Seed(); seed != 42 {
+		t.Errorf("Expected: 42; Got: %v", seed)
+	}
+
+	one, other := New(42), New(43)
+	same := 0
+	for range 100 {
+		if one.IntN(1000) == other.IntN(1000) {
+			same++
+		}
+	}
+	if same > 10 {
+		t.Errorf("expected another seed to roll other numbers, %v of 100 were the same", same)
+	}
+
+	randomly := NewRandom()
+	replay := New(randomly.Seed())
+	for range 100 {
+		if a, b := randomly.IntN(1000), replay.IntN(1000); a != b {
+			t.Fatalf("expected a random seed to be replayable; Got: %v and %v", a, b)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package typechart
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// romanNumerals are the generations as the PokeAPI names them, "generation-i" onwards
+var romanNumerals = []string{"i", "ii", "iii", "iv", "v", "vi", "vii", "viii", "ix", "x", "xi", "xii"}
+
+// ParseGeneration reads a generation given as a number ("3"), a roman numeral ("iii")
+// or a PokeAPI name ("generation-iii")
+func ParseGeneration(value string) (int, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if number, err := strconv.Atoi(value); err == nil && number > 0 {
+		return number, nil
+	}
+	if number := generationNumber(value); number > 0 {
+		return number, nil
+	}
+	if number := generationNumber("generation-" + value); number > 0 {
+		return number, nil
+	}
+	return 0, fmt.Errorf("typechart: not a generation: %q", value)
+}
+
+// generationNumber turns a PokeAPI generation name into its number, or 0 if it isn't one
+func generationNumber(name string) int {
+	numeral, ok := strings.CutPrefix(name, "generation-")
+	if !ok {
+		return 0
+	}
+	for i, candidate := range romanNumerals {
+		if numeral == candidate {
+			return i + 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,133 @@
+package typechart
+
+import (
+	"slices"
+)
+
+// Member is one Pokemon of a team, by its types
+type Member struct {
+	Name  string
+	Types []string
+}
+
+// TypeCount is an attacking type with how many team members it concerns
+type TypeCount struct {
+	Type  string
+	Count int
+}
+
+// TeamReport is how a team holds up against, and hits, every type in the chart.
+// Types are listed in chart order.
+type TeamReport struct {
+	// SharedWeaknesses are attacking types that are super effective against more than one member
+	SharedWeaknesses []TypeCount
+	// Unresisted are attacking types no member takes reduced (or no) damage from
+	Unresisted []string
+	// CoverageGaps are defending types none of the team's STAB (same-type attack bonus)
+	// types hit super effectively
+	CoverageGaps []string
+	// Suggestion is the type a seventh member should have to fix the most of the above,
+	// empty if no type would help
+	Suggestion string
+	// SuggestionFixes is what adding Suggestion fixes, by category
+	SuggestionFixes SuggestionFixes
+}
+
+// SuggestionFixes counts the holes a suggested type fixes
+type SuggestionFixes struct {
+	// SharedWeaknesses it resists
+	SharedWeaknesses int
+	// Unresisted types it resists
+	Unresisted int
+	// CoverageGaps it hits super effectively
+	CoverageGaps int
+}
+
+func (f SuggestionFixes) total() int {
+	return f.SharedWeaknesses + f.Unresisted + f.CoverageGaps
+}
+
+// AnalyzeTeam reports the shared weaknesses, unresisted types and STAB coverage gaps of team,
+// along with the type that would fix the most of them
+func (c *Chart) AnalyzeTeam(team []Member) (TeamReport, error) {
+	report := TeamReport{}
+	stab := []string{}
+	for _, member := range team {
+		for _, memberType := range member.Types {
+			if !c.Has(memberType) {
+				return report, &UnknownTypeError{Type: memberType}
+			}
+			if !slices.Contains(stab, memberType) {
+				stab = append(stab, memberType)
+			}
+		}
+	}
+
+	for _, attack := range c.types {
+		weak, resisted := 0, false
+		for _, member := range team {
+			multiplier, err := c.Multiplier(attack, member.Types...)
+			if err != nil {
+				return report, err
+			}
+			if multiplier > 1 {
+				weak++
+			}
+			if multiplier < 1 {
+				resisted = true
+			}
+		}
+		if weak > 1 {
+			report.SharedWeaknesses = append(report.SharedWeaknesses, TypeCount{Type: attack, Count: weak})
+		}
+		if !resisted {
+			report.Unresisted = append(report.Unresisted, attack)
+		}
+	}
+	for _, defender := range c.types {
+		if !c.hitsSuperEffectively(stab, defender) {
+			report.CoverageGaps = append(report.CoverageGaps, defender)
+		}
+	}
+
+	for _, candidate := range c.types {
+		fixes := SuggestionFixes{}
+		for _, weakness := range report.SharedWeaknesses {
+			if c.resists(candidate, weakness.Type) {
+				fixes.SharedWeaknesses++
+			}
+		}
+		for _, attack := range report.Unresisted {
+			if c.resists(candidate, attack) {
+				fixes.Unresisted++
+			}
+		}
+		for _, defender := range report.CoverageGaps {
+			if c.multipliers[candidate][defender] > 1 {
+				fixes.CoverageGaps++
+			}
+		}
+		// Ties go to the type that comes first in the chart
+		if fixes.total() > report.SuggestionFixes.total() {
+			report.Suggestion = candidate
+			report.SuggestionFixes = fixes
+		}
+	}
+	return report, nil
+}
+
+// hitsSuperEffectively reports whether any of attacks is super effective against defender
+func (c *Chart) hitsSuperEffectively(attacks []string, defender string) bool {
+	for _, attack := range attacks {
+		if c.multipliers[attack][defender] > 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// resists reports whether a single-typed defender takes reduced (or no) damage from attack
+func (c *Chart) resists(defender, attack string) bool {
+	multiplier, ok := c.multipliers[attack][defender]
+	return ok && multiplier < 1
+}
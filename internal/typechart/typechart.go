@@ -0,0 +1,205 @@
+// Package typechart works out how effective attacking types are against defending types,
+// from the damage relations the PokeAPI publishes for each type.
+package typechart
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pokeapi "github.com/avgra3/pokedexcli/internal/pokeapi"
+)
+
+// ErrUnknownType is returned for a type the chart does not have
+var ErrUnknownType = errors.New("typechart: unknown type")
+
+// UnknownTypeError names a type the chart does not have. It matches ErrUnknownType.
+type UnknownTypeError struct {
+	Type string
+}
+
+func (e *UnknownTypeError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrUnknownType, e.Type)
+}
+
+// Is lets errors.Is(err, ErrUnknownType) match
+func (e *UnknownTypeError) Is(target error) bool {
+	return target == ErrUnknownType
+}
+
+// loadConcurrency is how many types Load fetches at once
+const loadConcurrency = 8
+
+// Chart holds the damage multiplier of every attacking type against every defending type,
+// as of one generation of the games
+type Chart struct {
+	// generation the chart is for, 0 for the current one
+	generation int
+	// types in PokeAPI order
+	types []string
+	// multipliers[attacker][defender], missing pairs are normal damage
+	multipliers map[string]map[string]float64
+	// source is what the chart was built from, kept to build charts of other generations
+	source []pokeapi.Type
+}
+
+// Matchup is the damage multiplier of an attacking type against a defender
+type Matchup struct {
+	Type       string
+	Multiplier float64
+}
+
+// New builds the current chart from full type resources. Types without any damage relations,
+// like "unknown" and "shadow", can't be attacked with or hit and are left out.
+func New(types []pokeapi.Type) *Chart {
+	return build(types, 0)
+}
+
+// AtGeneration returns the chart as it was in generation (e.g. 5 for Black and White):
+// types introduced later are left out and past damage relations apply.
+// A generation of 0 means the current chart.
+func (c *Chart) AtGeneration(generation int) *Chart {
+	if generation == c.generation {
+		return c
+	}
+	return build(c.source, generation)
+}
+
+// Generation returns the generation the chart is for, 0 for the current one
+func (c *Chart) Generation() int {
+	return c.generation
+}
+
+func build(types []pokeapi.Type, generation int) *Chart {
+	chart := &Chart{generation: generation, multipliers: make(map[string]map[string]float64), source: types}
+	for _, attacker := range types {
+		if generation > 0 && generationNumber(attacker.Generation.Name) > generation {
+			continue
+		}
+		relations := relationsAt(attacker, generation)
+		if isEmpty(relations) {
+			continue
+		}
+		chart.types = append(chart.types, attacker.Name)
+		multipliers := make(map[string]float64)
+		for _, defender := range relations.DoubleDamageTo {
+			multipliers[defender.Name] = 2
+		}
+		for _, defender := range relations.HalfDamageTo {
+			multipliers[defender.Name] = 0.5
+		}
+		for _, defender := range relations.NoDamageTo {
+			multipliers[defender.Name] = 0
+		}
+		chart.multipliers[attacker.Name] = multipliers
+	}
+	return chart
+}
+
+// relationsAt returns a type's damage relations in generation. Each past entry holds the
+// relations up to and including its generation, so the earliest entry not before generation wins.
+func relationsAt(pokemonType pokeapi.Type, generation int) pokeapi.TypeRelations {
+	if generation <= 0 {
+		return pokemonType.DamageRelations
+	}
+	relations := pokemonType.DamageRelations
+	best := 0
+	for _, past := range pokemonType.PastDamageRelations {
+		pastGeneration := generationNumber(past.Generation.Name)
+		if pastGeneration >= generation && (best == 0 || pastGeneration < best) {
+			relations = past.DamageRelations
+			best = pastGeneration
+		}
+	}
+	return relations
+}
+
+// PokemonTypes lists a Pokemon's type names in slot order as of generation,
+// using its past types where they differ (e.g. Clefairy was Normal before generation 6).
+// A generation of 0 means the current types.
+func PokemonTypes(pokemon pokeapi.Pokemon, generation int) []string {
+	types := pokemon.Types
+	if generation > 0 {
+		best := 0
+		for _, past := range pokemon.PastTypes {
+			pastGeneration := generationNumber(past.Generation.Name)
+			if pastGeneration >= generation && (best == 0 || pastGeneration < best) {
+				types = past.Types
+				best = pastGeneration
+			}
+		}
+	}
+	names := []string{}
+	for _, pokemonType := range types {
+		names = append(names, pokemonType.Type.Name)
+	}
+	return names
+}
+
+// Load fetches every type from the PokeAPI and builds a chart from them
+func Load(ctx context.Context, client *pokeapi.Client) (*Chart, error) {
+	urls := []string{}
+	for typeRef, err := range pokeapi.NewPaginator[pokeapi.Type](client, "type", 100).All(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		urls = append(urls, typeRef.URL)
+	}
+	types := []pokeapi.Type{}
+	for _, result := range pokeapi.GetMany[pokeapi.Type](ctx, client, urls, loadConcurrency) {
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		types = append(types, result.Value)
+	}
+	return New(types), nil
+}
+
+// Types lists the types in the chart, in PokeAPI order
+func (c *Chart) Types() []string {
+	return c.types
+}
+
+// Has reports whether the chart knows typeName
+func (c *Chart) Has(typeName string) bool {
+	_, ok := c.multipliers[typeName]
+	return ok
+}
+
+// Multiplier is how much damage attack does to a defender of the given types.
+// For dual types the multipliers of each type multiply, e.g. 2 x 2 = 4.
+func (c *Chart) Multiplier(attack string, defenders ...string) (float64, error) {
+	multipliers, ok := c.multipliers[attack]
+	if !ok {
+		return 0, &UnknownTypeError{Type: attack}
+	}
+	total := 1.0
+	for _, defender := range defenders {
+		if !c.Has(defender) {
+			return 0, &UnknownTypeError{Type: defender}
+		}
+		if multiplier, ok := multipliers[defender]; ok {
+			total *= multiplier
+		}
+	}
+	return total, nil
+}
+
+// Defense is the multiplier of every attacking type against a defender of the given types,
+// in PokeAPI order
+func (c *Chart) Defense(defenders ...string) ([]Matchup, error) {
+	matchups := make([]Matchup, 0, len(c.types))
+	for _, attack := range c.types {
+		multiplier, err := c.Multiplier(attack, defenders...)
+		if err != nil {
+			return nil, err
+		}
+		matchups = append(matchups, Matchup{Type: attack, Multiplier: multiplier})
+	}
+	return matchups, nil
+}
+
+func isEmpty(relations pokeapi.TypeRelations) bool {
+	return len(relations.NoDamageTo)+len(relations.HalfDamageTo)+len(relations.DoubleDamageTo)+
+		len(relations.NoDamageFrom)+len(relations.HalfDamageFrom)+len(relations.DoubleDamageFrom) == 0
+}
@@ -0,0 +1,205 @@
+package typechart
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	pokeapi "github.com/avgra3/pokedexcli/internal/pokeapi"
+	pokeapitest "github.com/avgra3/pokedexcli/internal/pokeapitest"
+	pokecache "github.com/avgra3/pokedexcli/internal/pokecache"
+)
+
+func loadTestChart(t *testing.T) *Chart {
+	t.Helper()
+	server := pokeapitest.NewServer(pokeapitest.DefaultDataset())
+	t.Cleanup(server.Close)
+	client := pokeapi.NewClient(pokecache.NewCache(), server.ClientOptions()...)
+	chart, err := Load(context.Background(), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return chart
+}
+
+func TestLoad(t *testing.T) {
+	chart := loadTestChart(t)
+	types := chart.Types()
+	if len(types) != 18 || types[0] != "normal" || types[17] != "fairy" {
+		t.Errorf("expected the 18 types in order; Got: %v", types)
+	}
+	if slices.Contains(types, "unknown") {
+		t.Errorf("expected the unknown type to be left out")
+	}
+}
+
+func TestMultiplier(t *testing.T) {
+	chart := loadTestChart(t)
+	cases := []struct {
+		attack    string
+		defenders []string
+		expected  float64
+	}{
+		{attack: "water", defenders: []string{"fire"}, expected: 2},
+		{attack: "fire", defenders: []string{"water"}, expected: 0.5},
+		{attack: "normal", defenders: []string{"ghost"}, expected: 0},
+		{attack: "normal", defenders: []string{"normal"}, expected: 1},
+		{attack: "water", defenders: []string{"rock", "ground"}, expected: 4},
+		{attack: "fire", defenders: []string{"water", "dragon"}, expected: 0.25},
+		{attack: "electric", defenders: []string{"water", "ground"}, expected: 0},
+		{attack: "ice", defenders: []string{"water", "flying"}, expected: 1},
+	}
+	for _, c := range cases {
+		actual, err := chart.Multiplier(c.attack, c.defenders...)
+		if err != nil {
+			t.Errorf("Multiplier(%v, %v): unexpected error: %v", c.attack, c.defenders, err)
+			continue
+		}
+		if actual != c.expected {
+			t.Errorf("Multiplier(%v, %v): Expected: %v; Got: %v", c.attack, c.defenders, c.expected, actual)
+		}
+	}
+
+	if _, err := chart.Multiplier("sound", "normal"); !errors.Is(err, ErrUnknownType) {
+		t.Errorf("expected ErrUnknownType for an unknown attack; Got: %v", err)
+	}
+	if _, err := chart.Multiplier("normal", "sound"); !errors.Is(err, ErrUnknownType) {
+		t.Errorf("expected ErrUnknownType for an unknown defender; Got: %v", err)
+	}
+}
+
+func TestDefense(t *testing.T) {
+	chart := loadTestChart(t)
+	// Geodude: rock/ground
+	matchups, err := chart.Defense("rock", "ground")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]float64{"water": 4, "grass": 4, "electric": 0, "normal": 0.5, "poison": 0.25, "fighting": 2, "fire": 0.5}
+	for _, matchup := range matchups {
+		if multiplier, ok := expected[matchup.Type]; ok && multiplier != matchup.Multiplier {
+			t.Errorf("%v against rock/ground: Expected: %v; Got: %v", matchup.Type, multiplier, matchup.Multiplier)
+		}
+	}
+	if len(matchups) != 18 {
+		t.Errorf("expected a matchup for each of the 18 types; Got: %v", len(matchups))
+	}
+}
+
+func TestAtGeneration(t *testing.T) {
+	chart := loadTestChart(t)
+	cases := []struct {
+		generation int
+		types      int
+		attack     string
+		defender   string
+		expected   float64
+	}{
+		{generation: 0, types: 18, attack: "ghost", defender: "steel", expected: 1},
+		{generation: 6, types: 18, attack: "dark", defender: "steel", expected: 1},
+		{generation: 5, types: 17, attack: "ghost", defender: "steel", expected: 0.5},
+		{generation: 5, types: 17, attack: "dark", defender: "steel", expected: 0.5},
+		{generation: 2, types: 17, attack: "ghost", defender: "psychic", expected: 2},
+		{generation: 1, types: 15, attack: "ghost", defender: "psychic", expected: 0},
+	}
+	for _, c := range cases {
+		atGeneration := chart.AtGeneration(c.generation)
+		if len(atGeneration.Types()) != c.types {
+			t.Errorf("generation %v: expected %v types; Got: %v", c.generation, c.types, atGeneration.Types())
+		}
+		actual, err := atGeneration.Multiplier(c.attack, c.defender)
+		if err != nil {
+			t.Errorf("generation %v: unexpected error: %v", c.generation, err)
+			continue
+		}
+		if actual != c.expected {
+			t.Errorf("generation %v: %v against %v: Expected: %v; Got: %v", c.generation, c.attack, c.defender, c.expected, actual)
+		}
+	}
+
+	if _, err := chart.AtGeneration(5).Multiplier("fairy", "dragon"); !errors.Is(err, ErrUnknownType) {
+		t.Errorf("expected no fairy type in generation 5; Got: %v", err)
+	}
+	var unknown *UnknownTypeError
+	if _, err := chart.AtGeneration(5).Defense("fairy"); !errors.As(err, &unknown) || unknown.Type != "fairy" {
+		t.Errorf("expected the error to name the fairy type; Got: %v", err)
+	}
+}
+
+func TestPokemonTypes(t *testing.T) {
+	var clefairy pokeapi.Pokemon
+	for _, pokemon := range pokeapitest.DefaultDataset().Pokemon {
+		if pokemon.Name == "clefairy" {
+			clefairy = pokemon
+		}
+	}
+	cases := []struct {
+		generation int
+		expected   string
+	}{
+		{generation: 0, expected: "fairy"},
+		{generation: 6, expected: "fairy"},
+		{generation: 5, expected: "normal"},
+		{generation: 1, expected: "normal"},
+	}
+	for _, c := range cases {
+		actual := PokemonTypes(clefairy, c.generation)
+		if len(actual) != 1 || actual[0] != c.expected {
+			t.Errorf("generation %v: Expected: [%v]; Got: %v", c.generation, c.expected, actual)
+		}
+	}
+}
+
+func TestParseGeneration(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected int
+	}{
+		{input: "3", expected: 3},
+		{input: "iv", expected: 4},
+		{input: "generation-vi", expected: 6},
+		{input: "VIII", expected: 8},
+	}
+	for _, c := range cases {
+		actual, err := ParseGeneration(c.input)
+		if err != nil || actual != c.expected {
+			t.Errorf("ParseGeneration(%v): Expected: %v; Got: %v (%v)", c.input, c.expected, actual, err)
+		}
+	}
+	for _, input := range []string{"", "0", "-1", "gen", "generation-xx"} {
+		if _, err := ParseGeneration(input); err == nil {
+			t.Errorf("ParseGeneration(%v): expected an error", input)
+		}
+	}
+}
+
+func TestAnalyzeTeam(t *testing.T) {
+	chart := loadTestChart(t)
+	report, err := chart.AnalyzeTeam([]Member{
+		{Name: "pikachu", Types: []string{"electric"}},
+		{Name: "geodude", Types: []string{"rock", "ground"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report.SharedWeaknesses) != 1 || report.SharedWeaknesses[0] != (TypeCount{Type: "ground", Count: 2}) {
+		t.Errorf("expected ground as the only shared weakness; Got: %v", report.SharedWeaknesses)
+	}
+	expectedUnresisted := []string{"fighting", "ground", "bug", "ghost", "water", "grass", "psychic", "ice", "dragon", "dark", "fairy"}
+	if !slices.Equal(report.Unresisted, expectedUnresisted) {
+		t.Errorf("Expected unresisted: %v; Got: %v", expectedUnresisted, report.Unresisted)
+	}
+	expectedGaps := []string{"normal", "fighting", "ground", "ghost", "grass", "psychic", "dragon", "dark", "fairy"}
+	if !slices.Equal(report.CoverageGaps, expectedGaps) {
+		t.Errorf("Expected coverage gaps: %v; Got: %v", expectedGaps, report.CoverageGaps)
+	}
+	// Flying is immune to ground, resists fighting, bug and grass and hits fighting and grass
+	if report.Suggestion != "flying" || report.SuggestionFixes != (SuggestionFixes{SharedWeaknesses: 1, Unresisted: 4, CoverageGaps: 2}) {
+		t.Errorf("expected flying to be suggested; Got: %v %+v", report.Suggestion, report.SuggestionFixes)
+	}
+
+	if _, err := chart.AnalyzeTeam([]Member{{Name: "missingno", Types: []string{"bird"}}}); !errors.Is(err, ErrUnknownType) {
+		t.Errorf("expected ErrUnknownType; Got: %v", err)
+	}
+}
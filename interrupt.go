@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// interruptHandler turns Ctrl-C into cancelling the running command,
+// so a slow request drops us back at the prompt instead of killing the Pokedex
+type interruptHandler struct {
+	mu     sync.Mutex
+	cancel context.CancelFunc
+}
+
+func (h *interruptHandler) listen(signals <-chan os.Signal) {
+	for range signals {
+		h.mu.Lock()
+		if h.cancel != nil {
+			h.cancel()
+		} else {
+			// Nothing is running, just give the user a fresh prompt
+			fmt.Print("\n(use \"exit\" to close the Pokedex)\nPokedex > ")
+		}
+		h.mu.Unlock()
+	}
+}
+
+// commandContext returns a context for a single command.
+// The returned cancel func must be called once the command finishes.
+func (h *interruptHandler) commandContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	h.mu.Lock()
+	h.cancel = cancel
+	h.mu.Unlock()
+	return ctx, func() {
+		h.mu.Lock()
+		h.cancel = nil
+		h.mu.Unlock()
+		cancel()
+	}
+}
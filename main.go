@@ -1,130 +1,179 @@
 package main
 
 import (
-	"bufio"
+	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"maps"
 	"os"
+	"os/signal"
 	"strings"
 	"time"
 
+	inventory "github.com/avgra3/pokedexcli/internal/inventory"
 	pokeapi "github.com/avgra3/pokedexcli/internal/pokeapi"
 	pokecache "github.com/avgra3/pokedexcli/internal/pokecache"
 	pokecatch "github.com/avgra3/pokedexcli/internal/pokecatch"
+	random "github.com/avgra3/pokedexcli/internal/random"
+	typechart "github.com/avgra3/pokedexcli/internal/typechart"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "mirror" {
+		err := runMirror(os.Args[2:])
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	baseURL := flag.String("base-url", pokeapi.DefaultBaseURL, "base URL of the PokeAPI to query, e.g. a self-hosted mirror")
+	userAgent := flag.String("user-agent", pokeapi.DefaultUserAgent, "User-Agent header sent to the PokeAPI")
+	cacheDir := flag.String("cache-dir", "", "directory for the on-disk cache (default $XDG_CACHE_HOME/pokedexcli)")
+	cacheMaxBytes := flag.Int("cache-max-bytes", 64<<20, "most bytes of responses to keep in memory, 0 for no limit")
+	cacheMaxEntries := flag.Int("cache-max-entries", 0, "most responses to keep in memory, 0 for no limit")
+	cacheStaleFor := flag.Duration("cache-stale-for", 24*time.Hour, "how long an expired response may still be served while it is refreshed in the background")
+	offline := flag.Bool("offline", false, "read exclusively from a mirror written by \"pokedexcli mirror\"")
+	mirrorDir := flag.String("mirror-dir", defaultMirrorDir, "directory of the mirror used by --offline")
+	noDiskCache := flag.Bool("no-disk-cache", false, "only cache responses in memory for this session")
+	language := flag.String("lang", pokeapi.DefaultLanguage, "language of the Pokedex entries shown by inspect, e.g. ja, fr or de")
+	// Any number is a valid seed, so not passing the flag at all is what picks one at random
+	var seeded *random.Seeded
+	flag.Func("seed", "seed for catches and battles, to replay a session exactly (default a random seed, shown by the seed command)", func(value string) error {
+		seed, err := parseSeed(value)
+		if err != nil {
+			return err
+		}
+		seeded = random.New(seed)
+		return nil
+	})
+	timeout := flag.Duration("timeout", pokeapi.DefaultRequestTimeout, "how long a single PokeAPI request may take, 0 for no limit")
+	flag.Parse()
+
 	userPokedex := make(map[string]pokeapi.Pokemon)
 	configuration := config{}
 	configuration.UserPokedex = userPokedex
+	configuration.language = *language
+	configuration.rng = seeded
 	interval := time.Second * 60
-	cachePointer := pokecache.NewCache(interval)
-
-	commands := map[string]cliCommand{
-		"exit": {
-			name:        "exit",
-			description: "Exit the Pokedex",
-			callback:    commandExit,
-		},
-		"help": {
-			name:        "help",
-			description: "Displays a help message",
-			callback:    commandHelp,
-		},
-		"map": {
-			name:        "map",
-			description: "The 20 locations in the Pokemon world",
-			callback:    commandMap,
-		},
-		"mapb": {
-			name:        "mapb",
-			description: "The previous 20 locations in the Pokemon world",
-			callback:    commandMapBack,
-		},
-		"explore": {
-			name:        "explore <LOCATION_NAME>",
-			description: "See all Pokemon at a given location",
-			callback:    commandExplore,
-		},
-		"catch": {
-			name:        "catch <POKEMON_NAME>",
-			description: "Attempt to catch a Pokemon",
-			callback:    commandCatch,
-		},
-		"inspect": {
-			name:        "inspect <POKEMON_NAME>",
-			description: "Will return the name, heigjt, weight, stats and type(s) of the pokemon.",
-			callback:    commandInspect,
-		},
-		"pokedex": {
-			name:        "pokedex",
-			description: "See all Pokemon currently in your pokedex",
-			callback:    commandPokedex,
-		},
-	}
-
-	scanner := bufio.NewScanner(os.Stdin)
-	for {
-		fmt.Print("Pokedex > ")
-		scanner.Scan()
-		input := scanner.Text()
-		cleaned := strings.Fields(strings.ToLower(input))
-		value, ok := commands[cleaned[0]]
-		if !ok {
-			fmt.Println("Unknown command")
+	cacheOptions := []pokecache.Option{
+		pokecache.WithInterval(interval),
+		pokecache.WithStaleWhileRevalidate(*cacheStaleFor),
+		pokecache.WithMaxBytes(*cacheMaxBytes),
+		pokecache.WithMaxEntries(*cacheMaxEntries),
+	}
+	// The mirror is already on disk, no need to cache it twice
+	if !*noDiskCache && !*offline {
+		backend, err := newDiskCache(*cacheDir)
+		if err != nil {
+			fmt.Printf("Not caching to disk: %v\n", err)
+		} else {
+			cacheOptions = append(cacheOptions, pokecache.WithBackend(backend))
 		}
-		if ok {
-			searchTerm := ""
-			if len(cleaned) > 1 {
-				searchTerm = cleaned[1]
-			}
-			err := value.callback(&configuration, cachePointer, searchTerm)
-			if err != nil {
-				fmt.Println(err)
-			}
+	}
+	cachePointer := pokecache.NewCache(cacheOptions...)
+	defer cachePointer.Close()
+	clientOptions := []pokeapi.ClientOption{
+		pokeapi.WithBaseURL(*baseURL),
+		pokeapi.WithUserAgent(*userAgent),
+		pokeapi.WithRequestTimeout(*timeout),
+	}
+	if *offline {
+		clientOptions = append(clientOptions, pokeapi.WithOffline(*mirrorDir))
+	}
+	configuration.pokeapiClient = pokeapi.NewClient(cachePointer, clientOptions...)
+
+	// Ctrl-C cancels the running command rather than exiting
+	interrupts := &interruptHandler{}
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	go interrupts.listen(signals)
+
+	startRepl(os.Stdin, &configuration, cachePointer, interrupts)
+	// End of input (Ctrl-D)
+	commandExit(context.Background(), &configuration, cachePointer, nil)
+}
+
+// newDiskCache opens the on-disk cache in dir, or the default location when dir is empty
+func newDiskCache(dir string) (*pokecache.FileBackend, error) {
+	if dir == "" {
+		defaultDir, err := pokecache.DefaultDir()
+		if err != nil {
+			return nil, err
 		}
+		dir = defaultDir
 	}
+	return pokecache.NewFileBackend(dir)
 }
 
-func cleanInput(text string) []string {
-	cleanedText := strings.TrimSpace(text)
-	cleanedText = strings.ToLower(cleanedText)
-	return strings.Fields(cleanedText)
+// argument returns args[i], or an empty string if there are not that many arguments
+func argument(args []string, i int) string {
+	if i < len(args) {
+		return args[i]
+	}
+	return ""
 }
 
-func commandExit(configuration *config, cache *pokecache.Cache, input string) error {
+// option takes "--name value" or "--name=value" out of args, returning the value
+// (empty when the option is not there) and the other arguments
+func option(args []string, name string) (string, []string, error) {
+	flag := "--" + name
+	rest := []string{}
+	value := ""
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == flag:
+			if i+1 >= len(args) {
+				return "", args, fmt.Errorf("%v needs a value", flag)
+			}
+			value = args[i+1]
+			i++
+		case strings.HasPrefix(args[i], flag+"="):
+			value = strings.TrimPrefix(args[i], flag+"=")
+		default:
+			rest = append(rest, args[i])
+		}
+	}
+	return value, rest, nil
+}
+
+func commandExit(ctx context.Context, configuration *config, cache *pokecache.Cache, args []string) error {
 	fmt.Println("Closing the Pokedex... Goodbye!")
 	os.Exit(0)
 	return nil
 }
 
-func commandHelp(configuration *config, cache *pokecache.Cache, input string) error {
-	message := fmt.Sprintf("Welcome to the Pokedex!\nUsage:\n\nhelp: Displays a help message\nexit: Exit the Pokedex\nexplore <LOCATION_NAME>: Display all pokemon at a given location.\ncatch <POKEMON_NAME>: Attempt to catch a new pokemon. New Pokemon are added to the user's Pokedex\npokedex: See all Pokemon currently in your pokedex.")
+func commandHelp(ctx context.Context, configuration *config, cache *pokecache.Cache, args []string) error {
+	message := fmt.Sprintf("Welcome to the Pokedex!\nUsage:\n\nhelp: Displays a help message\nexit: Exit the Pokedex\nexplore <LOCATION_NAME>: Display all pokemon at a given location.\ncatch <POKEMON_NAME> [--ball BALL]: Attempt to catch a new pokemon with a ball from your inventory. New Pokemon are added to the user's Pokedex\ninventory: See the balls you are carrying.\nmatchup [--gen N] [ATTACK_TYPE] <POKEMON_NAME>: Show how effective attacking types are against a Pokemon, optionally as of generation N.\nteam analyze [--gen N] <POKEMON_NAME>...: Analyze up to six caught Pokemon as a team.\nbattle [--gen N] <MY_POKEMON> <WILD_POKEMON>: Battle one of your caught Pokemon against a wild one, optionally with the types of generation N.\nevolution <POKEMON_NAME>: Show the evolution tree of a Pokemon and what triggers each evolution.\nseed [SEED]: Show the seed catches and battles are rolled with, or start over from SEED to replay a session.\npokedex: See all Pokemon currently in your pokedex.\ncache <stats|list|clear|evict <KEY>>: Inspect and manage cached PokeAPI responses.")
 	fmt.Println(message)
 	return nil
 }
 
-func commandMap(configuration *config, cache *pokecache.Cache, input string) error {
+func commandMap(ctx context.Context, configuration *config, cache *pokecache.Cache, args []string) error {
 	// Get 20 location areas in the Pokemon world
 	// Each subsequent call gets the next 20 locations
-	const POKEAPI = "https://pokeapi.co/api/v2/location-area"
-	locationsResult, err := pokeapi.GetLocations(POKEAPI, cache, input)
+	locationsResult, err := configuration.locationAreaPages().Next(ctx)
+	if errors.Is(err, pokeapi.ErrNoMorePages) {
+		return errors.New("There is no \"next\" page of locations")
+	}
 	if err != nil {
-		return err
+		return friendlyError(ctx, configuration, "location-area", "location area", "", err)
 	}
+	for _, value := range locationsResult.Results {
+		fmt.Println(value.Name)
+	}
+	return nil
+}
 
-	if configuration.Next != "" {
-		_, err := pokeapi.GetLocations(configuration.Next, cache, input)
-		if err != nil {
-			return err
-		}
+func commandMapBack(ctx context.Context, configuration *config, cache *pokecache.Cache, args []string) error {
+	locationsResult, err := configuration.locationAreaPages().Prev(ctx)
+	if errors.Is(err, pokeapi.ErrNoMorePages) {
+		return errors.New("There is no \"previous\" page of locations")
 	}
-	configuration.Next = locationsResult.Next
-	configuration.Previous = locationsResult.Previous
-	// Allow for first page to just return the current page
-	if locationsResult.Previous == "" {
-		configuration.Previous = POKEAPI
+	if err != nil {
+		return friendlyError(ctx, configuration, "location-area", "location area", "", err)
 	}
 	for _, value := range locationsResult.Results {
 		fmt.Println(value.Name)
@@ -132,48 +181,77 @@ func commandMap(configuration *config, cache *pokecache.Cache, input string) err
 	return nil
 }
 
-func commandMapBack(configuration *config, cache *pokecache.Cache, input string) error {
-	previousApiUrl := configuration.Previous
-	if previousApiUrl != "" {
-		locationsResult, err := pokeapi.GetLocations(previousApiUrl, cache, input)
-		if err != nil {
-			return err
-		}
-		configuration.Next = locationsResult.Next
-		configuration.Previous = locationsResult.Previous
-		if locationsResult.Previous == "" {
-			configuration.Previous = previousApiUrl
-		}
-
-		for _, value := range locationsResult.Results {
-			fmt.Println(value.Name)
+func commandCatch(ctx context.Context, configuration *config, cache *pokecache.Cache, args []string) error {
+	ball, args, err := option(args, "ball")
+	if err != nil {
+		return err
+	}
+	if ball == "" {
+		ball = defaultBall
+	}
+	input := argument(args, 0)
+	if input == "" {
+		return errors.New("usage: catch <POKEMON_NAME> [--ball BALL]")
+	}
+	if _, err := pokecatch.BallBonus(ball, pokecatch.Conditions{}); err != nil {
+		if suggestion, ok := closestMatch(ball, pokecatch.Balls()); ok {
+			return fmt.Errorf("no ball named '%v' — did you mean %v?", ball, suggestion)
 		}
-		return nil
-
+		return fmt.Errorf("no ball named '%v', try one of: %v", ball, strings.Join(pokecatch.Balls(), ", "))
+	}
+	if configuration.bag().Count(ball) == 0 {
+		return fmt.Errorf("you have no %v left, see inventory", ballLabel(ball))
 	}
-	e := errors.New("There is no \"previous\" page of locations")
-	return e
-}
-
-func commandCatch(configuration *config, cache *pokecache.Cache, input string) error {
 
-	attemptMessage := fmt.Sprintf("Throwing a Pokeball at %v...", input)
+	attemptMessage := fmt.Sprintf("Throwing a %v at %v...", ballLabel(ball), input)
 	fmt.Println(attemptMessage)
 
 	// Get pokemon info
-	url := "https://pokeapi.co/api/v2/pokemon/" + input
-	pokemonInfo, err := pokeapi.GetPokemon(url, cache, input)
+	pokemonInfo, err := configuration.pokeapiClient.Pokemon(ctx, input)
+	if err != nil {
+		return friendlyError(ctx, configuration, "pokemon", "Pokemon", input, err)
+	}
+	// The capture rate belongs to the species
+	species, err := pokemonInfo.Species.Resolve(ctx, configuration.pokeapiClient)
+	if err != nil {
+		return friendlyError(ctx, configuration, "pokemon-species", "Pokemon species", pokemonInfo.Species.Name, err)
+	}
+	_, alreadyCaught := configuration.UserPokedex[pokemonInfo.Name]
+	// Catching happens outside of battle, so every ball is thrown on the first turn
+	ballBonus, err := pokecatch.BallBonus(ball, pokecatch.Conditions{
+		Types:         typechart.PokemonTypes(pokemonInfo, 0),
+		AlreadyCaught: alreadyCaught,
+		Dark:          isNight(configuration.now()),
+		Turn:          1,
+	})
 	if err != nil {
 		return err
 	}
-	// Get chances of success
-	successMin := pokemonInfo.BaseExperience
+	// Only a ball that was actually thrown is used up
+	if err := configuration.bag().Use(ball); err != nil {
+		return err
+	}
+	// A wild Pokemon met outside of battle is at full health
+	maxHP := baseStat(pokemonInfo, "hp")
+	result := pokecatch.Throw(pokecatch.Attempt{
+		CaptureRate: species.CaptureRate,
+		MaxHP:       maxHP,
+		HP:          maxHP,
+		BallBonus:   ballBonus,
+		StatusBonus: pokecatch.StatusNone,
+		Registered:  len(configuration.UserPokedex),
+	}, configuration.random())
 
 	// Need a success and failure message
 	success := fmt.Sprintf("%v was caught!", input)
 	failure := fmt.Sprintf("%v escaped!", input)
-	caught := pokecatch.SuccessfulCatch(successMin)
-	if caught {
+	if result.Critical {
+		fmt.Println("A critical capture!")
+	}
+	for range result.Shakes {
+		fmt.Println("...the ball shook...")
+	}
+	if result.Caught {
 		(*configuration).UserPokedex[pokemonInfo.Name] = pokemonInfo
 		fmt.Println(success)
 	} else {
@@ -183,13 +261,30 @@ func commandCatch(configuration *config, cache *pokecache.Cache, input string) e
 	return nil
 }
 
-func commandExplore(configuration *config, cache *pokecache.Cache, input string) error {
-	// Base lookup location
-	baseUrl := "https://pokeapi.co/api/v2/location-area/" + input
+// isNight is when the Dusk Ball works best, from 8pm to 6am
+func isNight(now time.Time) bool {
+	return now.Hour() >= 20 || now.Hour() < 6
+}
+
+// baseStat returns one of a Pokemon's base stats by name, e.g. "hp", or 0 if it has none
+func baseStat(pokemon pokeapi.Pokemon, name string) int {
+	for _, stat := range pokemon.Stats {
+		if stat.Stat.Name == name {
+			return stat.BaseStat
+		}
+	}
+	return 0
+}
+
+func commandExplore(ctx context.Context, configuration *config, cache *pokecache.Cache, args []string) error {
+	input := argument(args, 0)
+	if input == "" {
+		return errors.New("usage: explore <LOCATION_NAME>")
+	}
 	// Now try to get location
-	locationAreaDetails, err := pokeapi.GetLocationAreas(baseUrl, cache, input)
+	locationAreaDetails, err := configuration.pokeapiClient.LocationArea(ctx, input)
 	if err != nil {
-		return err
+		return friendlyError(ctx, configuration, "location-area", "location area", input, err)
 	}
 	// Our slice of pokemon encounters
 	pokemonEncounters := locationAreaDetails.PokemonEncounters
@@ -202,7 +297,8 @@ func commandExplore(configuration *config, cache *pokecache.Cache, input string)
 	return nil
 }
 
-func commandInspect(configuration *config, cache *pokecache.Cache, input string) error {
+func commandInspect(ctx context.Context, configuration *config, cache *pokecache.Cache, args []string) error {
+	input := argument(args, 0)
 	// Have a message that tells user if the Pokemon they are looking for does not exist
 	pokemon, ok := (*configuration).UserPokedex[input]
 	if !ok {
@@ -260,10 +356,39 @@ func commandInspect(configuration *config, cache *pokecache.Cache, input string)
 		fmt.Println(typeOut)
 	}
 
+	// Species details are not part of the Pokemon, fetch them (usually from the cache)
+	species, err := pokemon.Species.Resolve(ctx, configuration.pokeapiClient)
+	if err != nil {
+		return friendlyError(ctx, configuration, "pokemon-species", "Pokemon species", pokemon.Species.Name, err)
+	}
+	habitat := species.Habitat.Name
+	if habitat == "" {
+		habitat = "unknown"
+	}
+	fmt.Printf("Genus: %v\n", species.Genus(configuration.language))
+	fmt.Printf("Legendary: %v\n", yesNo(species.IsLegendary))
+	fmt.Printf("Mythical: %v\n", yesNo(species.IsMythical))
+	fmt.Printf("Habitat: %v\n", habitat)
+	fmt.Printf("Capture rate: %v\n", species.CaptureRate)
+	fmt.Println("Egg groups:")
+	for _, eggGroup := range species.EggGroups {
+		fmt.Printf("\t- %v\n", eggGroup.Name)
+	}
+	if flavorText := species.FlavorText(configuration.language); flavorText != "" {
+		fmt.Printf("Pokedex entry: %v\n", flavorText)
+	}
+
 	return nil
 }
 
-func commandPokedex(configuration *config, cache *pokecache.Cache, input string) error {
+func yesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+	return "no"
+}
+
+func commandPokedex(ctx context.Context, configuration *config, cache *pokecache.Cache, args []string) error {
 
 	currentPokedex := (*configuration).UserPokedex
 	pokemonNames := maps.Keys(currentPokedex)
@@ -274,14 +399,51 @@ func commandPokedex(configuration *config, cache *pokecache.Cache, input string)
 	return nil
 }
 
-type cliCommand struct {
-	name        string
-	description string
-	callback    func(*config, *pokecache.Cache, string) error
+type config struct {
+	UserPokedex   map[string]pokeapi.Pokemon
+	pokeapiClient *pokeapi.Client
+	// language Pokedex entries are shown in, falling back to English when empty or unavailable
+	language string
+	// typeChart is loaded the first time a command needs it
+	typeChart *typechart.Chart
+	// locationAreas remembers which page of locations map and mapb are on
+	locationAreas *pokeapi.Paginator[pokeapi.LocationArea]
+	// inventory holds the trainer's balls, starting with inventory.StarterKit
+	inventory *inventory.Inventory
+	// rng rolls the dice for catches and battles; the same seed replays the same session
+	rng *random.Seeded
+	// clock tells the time of day, which some balls care about; nil means the wall clock
+	clock func() time.Time
+}
+
+// now returns the time of day according to the configured clock
+func (c *config) now() time.Time {
+	if c.clock == nil {
+		return time.Now()
+	}
+	return c.clock()
 }
 
-type config struct {
-	Next        string
-	Previous    string
-	UserPokedex map[string]pokeapi.Pokemon
+// random returns the source of catches and battles, seeding one at random the first time
+func (c *config) random() *random.Seeded {
+	if c.rng == nil {
+		c.rng = random.NewRandom()
+	}
+	return c.rng
+}
+
+// bag returns the trainer's inventory, handing out the starter kit the first time
+func (c *config) bag() *inventory.Inventory {
+	if c.inventory == nil {
+		c.inventory = inventory.New(inventory.StarterKit())
+	}
+	return c.inventory
+}
+
+// locationAreaPages returns the paginator for map and mapb, starting before the first page
+func (c *config) locationAreaPages() *pokeapi.Paginator[pokeapi.LocationArea] {
+	if c.locationAreas == nil {
+		c.locationAreas = pokeapi.NewPaginator[pokeapi.LocationArea](c.pokeapiClient, "location-area", pokeapi.DefaultPageSize)
+	}
+	return c.locationAreas
 }
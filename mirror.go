@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+
+	pokeapi "github.com/avgra3/pokedexcli/internal/pokeapi"
+	pokecache "github.com/avgra3/pokedexcli/internal/pokecache"
+)
+
+// defaultMirrorDir is where `pokedexcli mirror` writes and `--offline` reads
+const defaultMirrorDir = "./data"
+
+// runMirror implements `pokedexcli mirror`, downloading PokeAPI resources for offline use
+func runMirror(args []string) error {
+	flags := flag.NewFlagSet("mirror", flag.ExitOnError)
+	dir := flags.String("dir", defaultMirrorDir, "directory to download the mirror into")
+	resources := flags.String("resources", "pokemon,location-area,pokemon-species", "comma separated list of PokeAPI resources to mirror")
+	baseURL := flags.String("base-url", pokeapi.DefaultBaseURL, "base URL of the PokeAPI to mirror")
+	userAgent := flags.String("user-agent", pokeapi.DefaultUserAgent, "User-Agent header sent to the PokeAPI")
+	timeout := flags.Duration("timeout", pokeapi.DefaultRequestTimeout, "how long a single PokeAPI request may take, 0 for no limit")
+	flags.Parse(args)
+
+	// Every response is written to the mirror, so the cache only needs to hold the list pages
+	cache := pokecache.NewCache(pokecache.WithMaxEntries(100))
+	defer cache.Close()
+	client := pokeapi.NewClient(
+		cache,
+		pokeapi.WithBaseURL(*baseURL),
+		pokeapi.WithUserAgent(*userAgent),
+		pokeapi.WithRequestTimeout(*timeout),
+	)
+
+	// Ctrl-C stops the download; running mirror again picks up where it left off
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	kinds := []string{}
+	for _, resource := range strings.Split(*resources, ",") {
+		if resource = strings.TrimSpace(resource); resource != "" {
+			kinds = append(kinds, resource)
+		}
+	}
+	err := client.Mirror(ctx, *dir, kinds, func(resource string, done, total int) {
+		fmt.Printf("\rMirroring %v: %d/%d", resource, done, total)
+		if done == total {
+			fmt.Println()
+		}
+	})
+	if err != nil {
+		fmt.Println()
+		return err
+	}
+	fmt.Printf("Mirror complete, use it with: pokedexcli --offline --mirror-dir %v\n", *dir)
+	return nil
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	pokecache "github.com/avgra3/pokedexcli/internal/pokecache"
+)
+
+type cliCommand struct {
+	name        string
+	description string
+	callback    func(context.Context, *config, *pokecache.Cache, []string) error
+	// keepCase passes the arguments as typed rather than lowercased, e.g. for cache keys
+	keepCase bool
+}
+
+func getCommands() map[string]cliCommand {
+	return map[string]cliCommand{
+		"exit": {
+			name:        "exit",
+			description: "Exit the Pokedex",
+			callback:    commandExit,
+		},
+		"help": {
+			name:        "help",
+			description: "Displays a help message",
+			callback:    commandHelp,
+		},
+		"map": {
+			name:        "map",
+			description: "The 20 locations in the Pokemon world",
+			callback:    commandMap,
+		},
+		"mapb": {
+			name:        "mapb",
+			description: "The previous 20 locations in the Pokemon world",
+			callback:    commandMapBack,
+		},
+		"explore": {
+			name:        "explore <LOCATION_NAME>",
+			description: "See all Pokemon at a given location",
+			callback:    commandExplore,
+		},
+		"catch": {
+			name:        "catch <POKEMON_NAME> [--ball BALL]",
+			description: "Attempt to catch a Pokemon, throwing a Poke Ball or BALL from your inventory",
+			callback:    commandCatch,
+		},
+		"inventory": {
+			name:        "inventory",
+			description: "See the balls you are carrying and what they do",
+			callback:    commandInventory,
+		},
+		"inspect": {
+			name:        "inspect <POKEMON_NAME>",
+			description: "Will return the name, height, weight, stats, type(s) and species details of a caught Pokemon.",
+			callback:    commandInspect,
+		},
+		"evolution": {
+			name:        "evolution <POKEMON_NAME>",
+			description: "Show the evolution tree of a Pokemon and what triggers each evolution",
+			callback:    commandEvolution,
+		},
+		"matchup": {
+			name:        "matchup [--gen N] [ATTACK_TYPE] <POKEMON_NAME>",
+			description: "Show how effective every attacking type, or just ATTACK_TYPE, is against a Pokemon, optionally as of generation N",
+			callback:    commandMatchup,
+		},
+		"team": {
+			name:        "team analyze [--gen N] <POKEMON_NAME>...",
+			description: "Analyze up to six caught Pokemon as a team: shared weaknesses, unresisted types, STAB coverage gaps and a suggested seventh type",
+			callback:    commandTeam,
+		},
+		"battle": {
+			name:        "battle [--gen N] <MY_POKEMON> <WILD_POKEMON>",
+			description: "Battle one of your caught Pokemon against a wild one, turn by turn, optionally with the types of generation N",
+			callback:    commandBattle,
+		},
+		"seed": {
+			name:        "seed [SEED]",
+			description: "Show the seed catches and battles are rolled with, or start over from SEED to replay a session",
+			callback:    commandSeed,
+		},
+		"pokedex": {
+			name:        "pokedex",
+			description: "See all Pokemon currently in your pokedex",
+			callback:    commandPokedex,
+		},
+		"cache": {
+			name:        "cache <stats|list|clear|evict <KEY>>",
+			description: "Inspect and manage cached PokeAPI responses",
+			callback:    commandCache,
+			keepCase:    true,
+		},
+	}
+}
+
+// startRepl reads commands from input and runs them until the input ends
+func startRepl(input io.Reader, configuration *config, cache *pokecache.Cache, interrupts *interruptHandler) {
+	commands := getCommands()
+	scanner := bufio.NewScanner(input)
+	for {
+		fmt.Print("Pokedex > ")
+		if !scanner.Scan() {
+			return
+		}
+		cleaned := cleanInput(scanner.Text())
+		if len(cleaned) == 0 {
+			continue
+		}
+		value, ok := commands[cleaned[0]]
+		if !ok {
+			fmt.Println("Unknown command")
+			continue
+		}
+		args := cleaned[1:]
+		if value.keepCase {
+			args = strings.Fields(scanner.Text())[1:]
+		}
+		ctx, cancel := interrupts.commandContext()
+		err := value.callback(ctx, configuration, cache, args)
+		cancel()
+		if err != nil {
+			fmt.Println(err)
+		}
+	}
+}
+
+func cleanInput(text string) []string {
+	cleanedText := strings.TrimSpace(text)
+	cleanedText = strings.ToLower(cleanedText)
+	return strings.Fields(cleanedText)
+}
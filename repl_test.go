@@ -1,6 +1,16 @@
 package main
 
-import "testing"
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pokeapi "github.com/avgra3/pokedexcli/internal/pokeapi"
+	pokeapitest "github.com/avgra3/pokedexcli/internal/pokeapitest"
+	pokecache "github.com/avgra3/pokedexcli/internal/pokecache"
+	random "github.com/avgra3/pokedexcli/internal/random"
+)
 
 func TestCleanInput(t *testing.T) {
 	// Setup of test case structs
@@ -52,3 +62,468 @@ func TestCleanInput(t *testing.T) {
 	}
 
 }
+
+// newServerConfig returns a configuration whose client talks to a fake PokeAPI
+func newServerConfig(server *pokeapitest.Server, opts ...pokeapi.ClientOption) *config {
+	opts = append(opts, pokeapi.WithRetryPolicy(pokeapi.RetryPolicy{MaxAttempts: 1}))
+	return &config{
+		UserPokedex:   make(map[string]pokeapi.Pokemon),
+		pokeapiClient: pokeapi.NewClient(pokecache.NewCache(), server.ClientOptions(opts...)...),
+		clock:         fixedClock(testNoon),
+	}
+}
+
+// testNoon is the time of day tests run at, so the Dusk Ball does not depend on when they run
+var testNoon = time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+// fixedClock is a clock that is always at t
+func fixedClock(t time.Time) func() time.Time {
+	return func() time.Time { return t }
+}
+
+// runRepl feeds script to the REPL and returns what it printed
+func runRepl(t *testing.T, configuration *config, script string) string {
+	t.Helper()
+	return captureOutput(t, func() error {
+		startRepl(strings.NewReader(script), configuration, nil, &interruptHandler{})
+		return nil
+	})
+}
+
+func TestReplEndToEnd(t *testing.T) {
+	server := pokeapitest.NewServer(pokeapitest.DefaultDataset())
+	defer server.Close()
+	configuration := newServerConfig(server)
+
+	output := runRepl(t, configuration, "mapb\nmap\nexplore canalave-city-area\ncatch PIKACHU\ncatch pikachuu\nexplore nowhere\ndance\n")
+	for _, expected := range []string{
+		"There is no \"previous\" page of locations\n",
+		"Pokedex > canalave-city-area\n",
+		"mt-coronet-1f-from-exterior\n",
+		"Exploring canalave-city-area...\n- tentacool\n- magikarp\n",
+		"Throwing a Poke Ball at pikachu...\n",
+		"no Pokemon named 'pikachuu' — did you mean pikachu?\n",
+		"no location area named 'nowhere'\n",
+		"Unknown command\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected %q in output: %v", expected, output)
+		}
+	}
+	if strings.Contains(output, "mt-coronet-1f-route-216") {
+		t.Errorf("map printed more than the first page: %v", output)
+	}
+	_, caught := configuration.UserPokedex["pikachu"]
+	if caught != strings.Contains(output, "pikachu was caught!") {
+		t.Errorf("the Pokedex does not match the output: %v", output)
+	}
+}
+
+func TestReplMapNavigation(t *testing.T) {
+	server := pokeapitest.NewServer(pokeapitest.DefaultDataset())
+	defer server.Close()
+	configuration := newServerConfig(server)
+
+	output := runRepl(t, configuration, "map\nmap\nmap\nmap\nmapb\nmapb\nmapb\n")
+	// Every command's output follows a prompt
+	outputs := strings.Split(output, "Pokedex > ")[1:]
+	expected := []string{
+		"canalave-city-area",
+		"mt-coronet-1f-route-216",
+		"solaceon-ruins-b3f-d",
+		"There is no \"next\" page of locations",
+		"mt-coronet-1f-route-216",
+		"canalave-city-area",
+		"There is no \"previous\" page of locations",
+	}
+	if len(outputs) < len(expected) {
+		t.Fatalf("expected output for %v commands: %v", len(expected), output)
+	}
+	for i, first := range expected {
+		if !strings.HasPrefix(outputs[i], first+"\n") {
+			t.Errorf("command %v: expected output starting with %q; Got: %q", i+1, first, outputs[i])
+		}
+	}
+}
+
+func TestReplEvolution(t *testing.T) {
+	server := pokeapitest.NewServer(pokeapitest.DefaultDataset())
+	defer server.Close()
+	configuration := newServerConfig(server)
+
+	output := runRepl(t, configuration, "evolution charmander\nevolution eevee\nevolution pikachu\nevolution magikarp\n")
+	for _, expected := range []string{
+		"charmander\n`-- charmeleon (level 16)\n    `-- charizard (level 36)\n",
+		"eevee\n|-- vaporeon (use water-stone)\n|-- jolteon (use thunder-stone)\n|-- flareon (use fire-stone)\n",
+		"|-- espeon (high friendship, daytime)\n|-- umbreon (high friendship, nighttime)\n",
+		"|-- leafeon (at eterna-forest or use leaf-stone)\n",
+		"`-- sylveon (knows a fairy move, high affection)\n",
+		"pichu\n`-- pikachu (high friendship)\n    `-- raichu (use thunder-stone)\n",
+		"magikarp has no evolution chain\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected %q in output: %v", expected, output)
+		}
+	}
+}
+
+func TestReplMatchup(t *testing.T) {
+	server := pokeapitest.NewServer(pokeapitest.DefaultDataset())
+	defer server.Close()
+	configuration := newServerConfig(server)
+
+	output := runRepl(t, configuration, "matchup geodude\nmatchup water geodude\nmatchup ground pidgey\nmatchup watr geodude\n")
+	for _, expected := range []string{
+		"geodude (rock/ground)\n4x: water, grass\n2x: fighting, ground, steel, ice\n",
+		"0.5x: normal, flying, rock, fire\n0.25x: poison\n0x: electric\n",
+		"water against geodude (rock/ground): 4x, super effective\n",
+		"ground against pidgey (normal/flying): 0x, no effect\n",
+		"no type named 'watr' — did you mean water?\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected %q in output: %v", expected, output)
+		}
+	}
+}
+
+func TestReplMatchupErrors(t *testing.T) {
+	// Without types the PokeAPI answers 404 while the type chart loads
+	dataset := pokeapitest.DefaultDataset()
+	dataset.Types = nil
+	server := pokeapitest.NewServer(dataset)
+	defer server.Close()
+	configuration := newServerConfig(server)
+
+	output := runRepl(t, configuration, "matchup water geodude extra\nmatchup geodude\n")
+	for _, expected := range []string{
+		"usage: matchup [--gen N] [ATTACK_TYPE] <POKEMON_NAME>\n",
+		"could not load the type chart, the PokeAPI has nothing at " + server.BaseURL() + "/type?offset=0&limit=100\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected %q in output: %v", expected, output)
+		}
+	}
+	if strings.Contains(output, "no type named") {
+		t.Errorf("expected no unknown type message: %v", output)
+	}
+}
+
+func TestReplMatchupGeneration(t *testing.T) {
+	server := pokeapitest.NewServer(pokeapitest.DefaultDataset())
+	defer server.Close()
+	configuration := newServerConfig(server)
+
+	output := runRepl(t, configuration, "matchup ghost clefairy\nmatchup --gen 5 ghost clefairy\nmatchup dark --gen=v clefairy\nmatchup --gen 5 fairy clefairy\nmatchup --gen x1 clefairy\n")
+	for _, expected := range []string{
+		"ghost against clefairy (fairy): 1x, normal damage\n",
+		"ghost against clefairy (normal) in generation 5: 0x, no effect\n",
+		"dark against clefairy (normal) in generation 5: 1x, normal damage\n",
+		"the fairy type did not exist yet in generation 5\n",
+		"--gen wants a generation like 3 or iii, not 'x1'\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected %q in output: %v", expected, output)
+		}
+	}
+}
+
+func TestReplTypeNotYetInGeneration(t *testing.T) {
+	server := pokeapitest.NewServer(pokeapitest.DefaultDataset())
+	defer server.Close()
+	// Sylveon came along with the Fairy type, so unlike Clefairy it has no past types
+	sylveon := pokeapitest.NewPokemon(700, "sylveon", 184, []string{"fairy"}, [6]int{95, 65, 65, 110, 130, 60})
+	server.Add("pokemon", sylveon.Id, sylveon.Name, sylveon)
+	configuration := newServerConfig(server)
+	configuration.UserPokedex["sylveon"] = sylveon
+
+	output := runRepl(t, configuration, "matchup --gen 5 sylveon\nmatchup --gen 5 ghost sylveon\nteam analyze --gen 5 sylveon\n")
+	if strings.Contains(output, "typechart:") {
+		t.Errorf("expected no raw typechart errors: %v", output)
+	}
+	if count := strings.Count(output, "the fairy type did not exist yet in generation 5\n"); count != 3 {
+		t.Errorf("expected all three commands to explain the missing type, %v did: %v", count, output)
+	}
+}
+
+func TestReplTeamAnalyze(t *testing.T) {
+	server := pokeapitest.NewServer(pokeapitest.DefaultDataset())
+	defer server.Close()
+	configuration := newServerConfig(server)
+	for _, name := range []string{"pikachu", "geodude"} {
+		pokemon, err := configuration.pokeapiClient.Pokemon(context.Background(), name)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		configuration.UserPokedex[name] = pokemon
+	}
+
+	output := runRepl(t, configuration, "team analyze pikachu geodude\nteam analyze pikachu mewtwo\nteam analyze\n")
+	for _, expected := range []string{
+		"Team: pikachu (electric), geodude (rock/ground)\n",
+		"Shared weaknesses:\n\t- ground (2 of 2)\nUnresisted attacking types:\n\t- fighting\n",
+		"STAB coverage gaps:\n\t- normal\n",
+		"Suggested seventh type: flying (resists 1 shared weaknesses and 4 unresisted types, covers 2 gaps)\n",
+		"you have not caught mewtwo\n",
+		"a team has between 1 and 6 Pokemon from your Pokedex\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected %q in output: %v", expected, output)
+		}
+	}
+}
+
+func TestReplBattle(t *testing.T) {
+	server := pokeapitest.NewServer(pokeapitest.DefaultDataset())
+	defer server.Close()
+	configuration := newServerConfig(server)
+	pikachu, err := configuration.pokeapiClient.Pokemon(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	configuration.UserPokedex["pikachu"] = pikachu
+	// A fixed seed keeps critical hits out of the first turn
+	configuration.rng = random.New(1)
+
+	output := runRepl(t, configuration, "battle pikachu geodude\nbattle geodude pikachu\nbattle pikachu geodud\n")
+	for _, expected := range []string{
+		"pikachu (102 HP) vs wild geodude (107 HP), both at level 50\nTurn 1:\n",
+		"\tpikachu used quick-attack: not very effective! wild geodude lost ",
+		"\twild geodude used bulldoze: super effective! pikachu lost ",
+		"you have not caught geodude\n",
+		"no Pokemon named 'geodud' — did you mean geodude?\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected %q in output: %v", expected, output)
+		}
+	}
+	if !strings.Contains(output, " won!\n") && !strings.Contains(output, "it's a draw\n") {
+		t.Errorf("expected the battle to finish: %v", output)
+	}
+}
+
+func TestReplBattleGeneration(t *testing.T) {
+	server := pokeapitest.NewServer(pokeapitest.DefaultDataset())
+	defer server.Close()
+	configuration := newServerConfig(server)
+	pikachu, err := configuration.pokeapiClient.Pokemon(context.Background(), "pikachu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	configuration.UserPokedex["pikachu"] = pikachu
+	configuration.rng = random.New(1)
+
+	output := runRepl(t, configuration, "battle --gen 5 pikachu clefairy\nbattle --gen x1 pikachu clefairy\n")
+	for _, expected := range []string{
+		"vs wild clefairy (",
+		"both at level 50 in generation 5\nTurn 1:\n",
+		"--gen wants a generation like 3 or iii, not 'x1'\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected %q in output: %v", expected, output)
+		}
+	}
+}
+
+func TestReplBattleFetchesFewMoves(t *testing.T) {
+	dataset := pokeapitest.DefaultDataset()
+	server := pokeapitest.NewServer(dataset)
+	defer server.Close()
+	configuration := newServerConfig(server)
+	// Mew knows every move, the newest being a status move
+	mew := pokeapitest.NewPokemon(151, "mew", 270, []string{"psychic"}, [6]int{100, 100, 100, 100, 100, 100})
+	for i, move := range dataset.Moves {
+		level := 40 - i
+		if move.Name == "growl" {
+			level = 50
+		}
+		pokeapitest.LearnMove(&mew, move, level)
+	}
+	server.Add("pokemon", mew.Id, mew.Name, mew)
+	mew, err := configuration.pokeapiClient.Pokemon(context.Background(), "mew")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	configuration.UserPokedex["mew"] = mew
+
+	// Load the type chart and magikarp first, so only moves are left to fetch
+	runRepl(t, configuration, "matchup magikarp\n")
+	before := server.Requests()
+	output := runRepl(t, configuration, "battle mew magikarp\n")
+	if !strings.Contains(output, "mew (167 HP) vs wild magikarp (87 HP), both at level 50\n") {
+		t.Fatalf("unexpected output: %v", output)
+	}
+	// Two batches of four get mew enough damaging moves, and magikarp's two moves are among them
+	if requests := server.Requests() - before; requests != 8 {
+		t.Errorf("expected 8 moves to be fetched, not all %v; Got: %v", len(dataset.Moves), requests)
+	}
+}
+
+func TestReplCatchWithoutBaseExperience(t *testing.T) {
+	server := pokeapitest.NewServer(pokeapitest.DefaultDataset())
+	defer server.Close()
+	// Some Pokemon have a null base_experience, which used to make catch panic
+	missingno := pokeapitest.NewPokemon(10001, "missingno", 0, []string{"normal"}, [6]int{33, 136, 0, 6, 6, 29})
+	server.Add("pokemon", missingno.Id, missingno.Name, missingno)
+	server.Add("pokemon-species", 10001, "missingno", pokeapitest.NewSpecies(10001, "missingno", 3, "??? Pokémon", "", ""))
+	configuration := newServerConfig(server)
+
+	output := runRepl(t, configuration, "catch missingno\n")
+	if !strings.Contains(output, "missingno was caught!\n") && !strings.Contains(output, "missingno escaped!\n") {
+		t.Errorf("expected the throw to finish: %v", output)
+	}
+}
+
+func TestReplInventory(t *testing.T) {
+	server := pokeapitest.NewServer(pokeapitest.DefaultDataset())
+	defer server.Close()
+	configuration := newServerConfig(server)
+
+	output := runRepl(t, configuration, "inventory\n"+
+		"catch pikachuu --ball master-ball\n"+
+		"catch pikachu --ball master-ball\n"+
+		"catch zubat --ball master-ball\n"+
+		"catch zubat --ball grate-ball\n"+
+		"catch zubat --ball=pokeflute\n"+
+		"inventory\n")
+	for _, expected := range []string{
+		"Your bag:\n",
+		"\t- Great Ball x10: A good, high-performance Poké Ball that provides a higher Pokémon catch rate than a standard Poké Ball.\n",
+		"\t- Master Ball x1: The best Poké Ball",
+		"Throwing a Master Ball at pikachuu...\n",
+		"Throwing a Master Ball at pikachu...\n",
+		"pikachu was caught!\n",
+		"you have no Master Ball left, see inventory\n",
+		"no ball named 'grate-ball' — did you mean great-ball?\n",
+		"no ball named 'pokeflute', try one of: dusk-ball, great-ball, master-ball, net-ball, poke-ball, quick-ball, repeat-ball, ultra-ball\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected %q in output: %v", expected, output)
+		}
+	}
+	// A ball is only used up when it is thrown at a Pokemon that exists
+	if !strings.HasSuffix(output, "\t- Great Ball x10: A good, high-performance Poké Ball that provides a higher Pokémon catch rate than a standard Poké Ball.\n"+
+		"\t- Net Ball x3: A somewhat different Poké Ball that is more effective when attempting to catch Water- or Bug-type Pokémon.\n"+
+		"\t- Poké Ball x20: A device for catching wild Pokémon. It's thrown like a ball at a Pokémon, comfortably encapsulating its target.\n"+
+		"\t- Quick Ball x3: A somewhat different Poké Ball that has a more successful catch rate if used at the start of a wild encounter.\n"+
+		"\t- Repeat Ball x3: A somewhat different Poké Ball that works especially well on a Pokémon species that has been caught before.\n"+
+		"\t- Ultra Ball x5: An ultra-high-performance Poké Ball that provides a higher success rate for catching Pokémon than a Great Ball.\n"+
+		"Pokedex > ") {
+		t.Errorf("unexpected inventory after the throws: %v", output)
+	}
+	if strings.Count(output, "Master Ball x1") != 1 {
+		t.Errorf("expected the master ball to be used up: %v", output)
+	}
+}
+
+func TestReplSeed(t *testing.T) {
+	server := pokeapitest.NewServer(pokeapitest.DefaultDataset())
+	defer server.Close()
+
+	// As if started with --seed 3
+	configuration := newServerConfig(server)
+	configuration.rng = random.New(3)
+	output := runRepl(t, configuration, "catch eevee\ncatch eevee\nseed\nseed 2\ncatch eevee\nseed -1\nseed 0\nseed\n")
+	expected := "Pokedex > Throwing a Poke Ball at eevee...\n" +
+		"eevee escaped!\n" +
+		"Pokedex > Throwing a Poke Ball at eevee...\n" +
+		"...the ball shook...\n...the ball shook...\n...the ball shook...\n" +
+		"eevee escaped!\n" +
+		"Pokedex > Seed: 3\n" +
+		"Pokedex > Catches and battles now roll from seed 2\n" +
+		"Pokedex > Throwing a Poke Ball at eevee...\n" +
+		"...the ball shook...\n...the ball shook...\n" +
+		"eevee escaped!\n" +
+		"Pokedex > a seed is a whole number from 0 up, not '-1'\n" +
+		"Pokedex > Catches and battles now roll from seed 0\n" +
+		"Pokedex > Seed: 0\n" +
+		"Pokedex > "
+	if output != expected {
+		t.Errorf("Expected:\n%v\nGot:\n%v", expected, output)
+	}
+
+	// The same seed replays a whole session, battles included
+	script := "seed 7\ncatch pikachu --ball master-ball\nbattle pikachu geodude\ncatch zubat\ncatch zubat --ball dusk-ball\n"
+	first := runRepl(t, newServerConfig(server), script)
+	second := runRepl(t, newServerConfig(server), script)
+	if first != second {
+		t.Errorf("expected the same seed to replay the session:\n%v\nthen:\n%v", first, second)
+	}
+}
+
+func TestReplDuskBall(t *testing.T) {
+	server := pokeapitest.NewServer(pokeapitest.DefaultDataset())
+	defer server.Close()
+	script := "seed 2\ncatch zubat --ball dusk-ball\n"
+
+	// The same seed and time of day always throw the same way
+	output := runRepl(t, newServerConfig(server), script)
+	if !strings.Contains(output, "zubat escaped!\n") {
+		t.Errorf("expected zubat to escape at noon: %v", output)
+	}
+
+	// At night the Dusk Ball triples the catch value, enough to catch zubat without fail
+	configuration := newServerConfig(server)
+	configuration.clock = fixedClock(time.Date(2024, time.June, 1, 23, 0, 0, 0, time.UTC))
+	output = runRepl(t, configuration, script)
+	if !strings.Contains(output, "zubat was caught!\n") {
+		t.Errorf("expected zubat to be caught at night: %v", output)
+	}
+}
+
+func TestReplSlowServer(t *testing.T) {
+	server := pokeapitest.NewServer(pokeapitest.DefaultDataset())
+	defer server.Close()
+	server.SetLatency(time.Second)
+	configuration := newServerConfig(server, pokeapi.WithRequestTimeout(10*time.Millisecond))
+
+	output := runRepl(t, configuration, "explore canalave-city-area\n")
+	if !strings.Contains(output, "the PokeAPI took too long to answer") {
+		t.Errorf("expected a timeout message: %v", output)
+	}
+}
+
+func TestOption(t *testing.T) {
+	cases := []struct {
+		input    []string
+		value    string
+		rest     []string
+		hasError bool
+	}{
+		{input: []string{"pikachu"}, value: "", rest: []string{"pikachu"}},
+		{input: []string{"--gen", "3", "pikachu"}, value: "3", rest: []string{"pikachu"}},
+		{input: []string{"water", "pikachu", "--gen=iv"}, value: "iv", rest: []string{"water", "pikachu"}},
+		{input: []string{"pikachu", "--gen"}, hasError: true},
+	}
+	for _, c := range cases {
+		value, rest, err := option(c.input, "gen")
+		if (err != nil) != c.hasError {
+			t.Errorf("option(%v): unexpected error: %v", c.input, err)
+			continue
+		}
+		if c.hasError {
+			continue
+		}
+		if value != c.value || strings.Join(rest, " ") != strings.Join(c.rest, " ") {
+			t.Errorf("option(%v): Expected: %q %v; Got: %q %v", c.input, c.value, c.rest, value, rest)
+		}
+	}
+}
+
+func TestReplCacheEvictKeepsCase(t *testing.T) {
+	server := pokeapitest.NewServer(pokeapitest.DefaultDataset())
+	defer server.Close()
+	configuration := newServerConfig(server)
+	cache := pokecache.NewCache()
+	cache.Add("https://example.com/Mixed-Case", []byte("{}"))
+
+	output := captureOutput(t, func() error {
+		startRepl(strings.NewReader("CACHE evict https://example.com/Mixed-Case\n"), configuration, cache, &interruptHandler{})
+		return nil
+	})
+	if !strings.Contains(output, "Evicted https://example.com/Mixed-Case") {
+		t.Errorf("expected the mixed-case key to be evicted: %v", output)
+	}
+	if cache.Stats().Entries != 0 {
+		t.Errorf("Expected an empty cache; Got: %v entries", cache.Stats().Entries)
+	}
+}